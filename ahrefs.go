@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -30,9 +31,17 @@ type Client struct {
 
 	// Service interface.
 	Service Service
+
+	// RetryPolicy controls how failed requests are retried. Requests are not
+	// retried when it is nil. Prefer WithRetryPolicy: changing it while the
+	// client is in use is not safe.
+	RetryPolicy *RetryPolicy
+
+	// Client-side rate limiter, see WithRateLimit.
+	limiter *rateLimiter
 }
 
-func NewClient(httpClient *http.Client, token string) *Client {
+func NewClient(httpClient *http.Client, token string, opts ...ClientOption) *Client {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 		httpClient.Timeout = time.Minute * 2
@@ -48,6 +57,10 @@ func NewClient(httpClient *http.Client, token string) *Client {
 	}
 	c.Service = &serviceImpl{c}
 
+	for _, opt := range opts {
+		opt(c)
+	}
+
 	return c
 }
 
@@ -74,74 +87,96 @@ func sanitizeURL(uri *url.URL) *url.URL {
 }
 
 func (c *Client) Do(ctx context.Context, builder *requestBuilder, v interface{}) (*http.Response, error) {
-	req, err := builder.request()
-	if err != nil {
-		return nil, err
-	}
-
-	if ctx == nil {
-		return nil, errors.New("context must be non-nil")
-	}
-	req = req.WithContext(ctx)
-
-	resp, err := c.client.Do(req)
+	resp, err := c.doRequest(ctx, builder)
 	if err != nil {
-		// If we got an error, and the context has been canceled,
-		// the context's error is probably more useful.
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-		}
-
-		// If the error type is *url.Error, sanitize its URL before returning.
-		if e, ok := err.(*url.Error); ok {
-			if url, err := url.Parse(e.URL); err == nil {
-				e.URL = sanitizeURL(url).String()
-				return nil, e
-			}
-		}
-
-		return nil, err
+		return resp, err
 	}
-
 	defer resp.Body.Close()
 
-	if code := resp.StatusCode; code < 200 || code >= 300 {
-		return resp, errors.New("unexpected status code")
+	if v == nil {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+		return resp, err
 	}
 
-	// TODO: do not read the whole payload in memory.
-	blob, err := ioutil.ReadAll(resp.Body)
+	err = json.NewDecoder(resp.Body).Decode(v)
+	return resp, err
+}
+
+// doRequest sends the request of builder, and returns the response with its
+// body still unread. The caller must close it.
+func (c *Client) doRequest(ctx context.Context, builder *requestBuilder) (*http.Response, error) {
+	req, err := builder.request()
 	if err != nil {
-		return resp, err
+		return nil, err
 	}
 
-	if status := resp.Header.Get("X-Status"); status == "error" {
-		apiErr := struct {
-			Error string `json:"error"`
-		}{}
-		err = json.Unmarshal(blob, &apiErr)
-		if err != nil {
-			return resp, err
-		}
-		return resp, errors.New(apiErr.Error)
+	if ctx == nil {
+		return nil, errors.New("context must be non-nil")
 	}
+	req = req.WithContext(ctx)
 
-	if v == nil {
-		return resp, nil
+	if builder.explain != nil {
+		builder.explain.record(req)
+		return nil, ErrDryRun
 	}
 
-	err = json.Unmarshal(blob, v)
-	return resp, err
+	return c.send(ctx, req)
 }
 
+// Service lists the methods of the API tables. Unless documented otherwise, a
+// method matches the target in subdomains mode and sends no select, where,
+// orderBy or limit parameter, so the defaults of the API apply. Options
+// override the defaults of the method; default filters are combined with
+// WithWhere and dropped by WithoutDefaultFilters.
 type Service interface {
+	// ReferringDomains lists the referring domains. It selects refdomain,
+	// domain_rating and backlinks, ordered by domain_rating descending. The
+	// refdomains table has no country dimension: results are not limited to
+	// a country, unlike earlier versions which filtered on "us", and
+	// WithCountry is rejected.
 	ReferringDomains(ctx context.Context, opts ...Option) (*ReferringDomainsResponse, *http.Response, error)
+	// ReferringDomainsByType lists the referring domains with link type
+	// counters, ordered by domain_rating descending. By default only dofollow
+	// links are counted; use WithoutDefaultFilters to count all links.
 	ReferringDomainsByType(ctx context.Context, opts ...Option) (*ReferringDomainsByTypeResponse, *http.Response, error)
+	ReferringDomainsNewLost(ctx context.Context, opts ...Option) (*ReferringDomainsNewLostResponse, *http.Response, error)
+	ReferringDomainsNewLostCounters(ctx context.Context, opts ...Option) (*ReferringDomainsNewLostCountersResponse, *http.Response, error)
+	ReferringIPs(ctx context.Context, opts ...Option) (*ReferringIPsResponse, *http.Response, error)
+	Backlinks(ctx context.Context, opts ...Option) (*BacklinksResponse, *http.Response, error)
 	BacklinksOnePerDomain(ctx context.Context, opts ...Option) (*BacklinksOnePerDomainResponse, *http.Response, error)
+	BacklinksNewLost(ctx context.Context, opts ...Option) (*BacklinksNewLostResponse, *http.Response, error)
+	BacklinksNewLostCounters(ctx context.Context, opts ...Option) (*BacklinksNewLostCountersResponse, *http.Response, error)
+	BrokenBacklinks(ctx context.Context, opts ...Option) (*BrokenBacklinksResponse, *http.Response, error)
+	BrokenLinks(ctx context.Context, opts ...Option) (*BrokenLinksResponse, *http.Response, error)
+	// PositionMetrics returns the organic search metrics for a country, "us"
+	// by default. Use WithCountry to pick another country, or
+	// WithoutDefaultFilters to drop the country filter.
 	PositionMetrics(ctx context.Context, opts ...Option) (*PositionMetricsResponse, *http.Response, error)
+	DomainRating(ctx context.Context, opts ...Option) (*DomainRatingResponse, *http.Response, error)
+	AhrefsRank(ctx context.Context, opts ...Option) (*AhrefsRankResponse, *http.Response, error)
+	Metrics(ctx context.Context, opts ...Option) (*MetricsResponse, *http.Response, error)
+	MetricsExtended(ctx context.Context, opts ...Option) (*MetricsExtendedResponse, *http.Response, error)
+	// SubscriptionInfo returns the limits of the API subscription. It takes no
+	// target and sends no mode.
+	SubscriptionInfo(ctx context.Context, opts ...Option) (*SubscriptionInfoResponse, *http.Response, error)
 	Pages(ctx context.Context, opts ...Option) (*PagesResponse, *http.Response, error)
+	PagesExtended(ctx context.Context, opts ...Option) (*PagesExtendedResponse, *http.Response, error)
+	PagesInfo(ctx context.Context, opts ...Option) (*PagesInfoResponse, *http.Response, error)
+	BacklinksPages(ctx context.Context, opts ...Option) (*BacklinksPagesResponse, *http.Response, error)
+	Anchors(ctx context.Context, opts ...Option) (*AnchorsResponse, *http.Response, error)
+	AnchorsRefdomains(ctx context.Context, opts ...Option) (*AnchorsRefdomainsResponse, *http.Response, error)
+	LinkedAnchors(ctx context.Context, opts ...Option) (*LinkedAnchorsResponse, *http.Response, error)
+	LinkedDomains(ctx context.Context, opts ...Option) (*LinkedDomainsResponse, *http.Response, error)
+	LinkedDomainsByType(ctx context.Context, opts ...Option) (*LinkedDomainsByTypeResponse, *http.Response, error)
+
+	// The Iter methods send the same requests as their counterparts, but
+	// decode the rows one at a time as the iterator advances, so that large
+	// results are never held in memory. Iterators stop when their context is
+	// done, and must be closed when not iterated to the end.
+	BacklinksIter(ctx context.Context, opts ...Option) *RefpageIterator
+	BacklinksOnePerDomainIter(ctx context.Context, opts ...Option) *RefpageIterator
+	PagesIter(ctx context.Context, opts ...Option) *PageIterator
+	ReferringDomainsIter(ctx context.Context, opts ...Option) *ReferringDomainIterator
 }
 
 type serviceImpl struct {
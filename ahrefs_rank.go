@@ -0,0 +1,29 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type AhrefsRankResponse struct {
+	Pages []AhrefsRank `json:"pages"`
+}
+
+type AhrefsRank struct {
+	URL        string `json:"url"`
+	AhrefsRank int64  `json:"ahrefs_rank"`
+}
+
+func (s *serviceImpl) AhrefsRank(ctx context.Context, opts ...Option) (*AhrefsRankResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("ahrefs_rank")
+	b.WithMode(ModeSubdomains)
+
+	payload := &AhrefsRankResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
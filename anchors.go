@@ -0,0 +1,33 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type AnchorsResponse struct {
+	Anchors []Anchor `json:"anchors"`
+}
+
+type Anchor struct {
+	Anchor           string    `json:"anchor"`
+	Backlinks        int64     `json:"backlinks"`
+	ReferringPages   int64     `json:"refpages"`
+	ReferringDomains int64     `json:"refdomains"`
+	FirstSeen        Timestamp `json:"first_seen"`
+	LastVisited      Timestamp `json:"last_visited"`
+}
+
+func (s *serviceImpl) Anchors(ctx context.Context, opts ...Option) (*AnchorsResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("anchors")
+	b.WithMode(ModeSubdomains)
+
+	payload := &AnchorsResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
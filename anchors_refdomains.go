@@ -0,0 +1,33 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type AnchorsRefdomainsResponse struct {
+	ReferringDomains []AnchorRefdomain `json:"refdomains"`
+}
+
+type AnchorRefdomain struct {
+	Anchor          string    `json:"anchor"`
+	ReferringDomain string    `json:"refdomain"`
+	DomainRating    int64     `json:"domain_rating"`
+	Backlinks       int64     `json:"backlinks"`
+	FirstSeen       Timestamp `json:"first_seen"`
+	LastVisited     Timestamp `json:"last_visited"`
+}
+
+func (s *serviceImpl) AnchorsRefdomains(ctx context.Context, opts ...Option) (*AnchorsRefdomainsResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("anchors_refdomains")
+	b.WithMode(ModeSubdomains)
+
+	payload := &AnchorsRefdomainsResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
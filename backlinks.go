@@ -0,0 +1,43 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type BacklinksResponse struct {
+	Refpages []Refpage      `json:"refpages"`
+	Stats    BacklinksStats `json:"stats"`
+}
+
+type BacklinksStats struct {
+	Backlinks int64 `json:"backlinks"`
+	Refpages  int64 `json:"refpages"`
+}
+
+func (s *serviceImpl) Backlinks(ctx context.Context, opts ...Option) (*BacklinksResponse, *http.Response, error) {
+	b := s.backlinksBuilder(ctx, opts)
+
+	payload := &BacklinksResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
+
+func (s *serviceImpl) BacklinksIter(ctx context.Context, opts ...Option) *RefpageIterator {
+	b := s.backlinksBuilder(ctx, opts)
+
+	return &RefpageIterator{rowIterator: s.client.iterate(ctx, b)}
+}
+
+// backlinksBuilder sets the defaults shared by Backlinks and BacklinksIter.
+func (s *serviceImpl) backlinksBuilder(ctx context.Context, opts []Option) *requestBuilder {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("backlinks")
+	b.WithMode(ModeSubdomains)
+
+	return b
+}
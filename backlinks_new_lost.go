@@ -0,0 +1,31 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type BacklinksNewLostResponse struct {
+	Refpages []BacklinkNewLost `json:"refpages"`
+}
+
+type BacklinkNewLost struct {
+	// Type is either "new" or "lost".
+	Type string    `json:"type"`
+	Date Timestamp `json:"date"`
+	Refpage
+}
+
+func (s *serviceImpl) BacklinksNewLost(ctx context.Context, opts ...Option) (*BacklinksNewLostResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("backlinks_new_lost")
+	b.WithMode(ModeSubdomains)
+
+	payload := &BacklinksNewLostResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
@@ -0,0 +1,34 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type BacklinksNewLostCountersResponse struct {
+	Counts []BacklinksNewLostCounter `json:"counts"`
+}
+
+// BacklinksNewLostCounter holds the number of new and lost backlinks for a
+// single date. The date column can be used in where clauses.
+type BacklinksNewLostCounter struct {
+	Date      Timestamp `json:"date"`
+	New       int64     `json:"new"`
+	Lost      int64     `json:"lost"`
+	NewTotal  int64     `json:"new_total"`
+	LostTotal int64     `json:"lost_total"`
+}
+
+func (s *serviceImpl) BacklinksNewLostCounters(ctx context.Context, opts ...Option) (*BacklinksNewLostCountersResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("backlinks_new_lost_counters")
+	b.WithMode(ModeSubdomains)
+
+	payload := &BacklinksNewLostCountersResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
@@ -0,0 +1,34 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type BacklinksPagesResponse struct {
+	Pages []BacklinksPage `json:"pages"`
+	Stats PagesStats      `json:"stats"`
+}
+
+// BacklinksPage is a page of the target together with the number of
+// backlinks pointing to it.
+type BacklinksPage struct {
+	Page
+	Backlinks      int64 `json:"backlinks"`
+	ReferringPages int64 `json:"refpages"`
+	RefDomains     int64 `json:"refdomains"`
+}
+
+func (s *serviceImpl) BacklinksPages(ctx context.Context, opts ...Option) (*BacklinksPagesResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("backlinks_pages")
+	b.WithMode(ModeSubdomains)
+
+	payload := &BacklinksPagesResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
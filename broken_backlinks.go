@@ -0,0 +1,26 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+// BrokenBacklinksResponse lists the backlinks pointing to pages of the target
+// that return an error. HTTPCode holds the status code of the linked page.
+type BrokenBacklinksResponse struct {
+	Refpages []Refpage `json:"refpages"`
+}
+
+func (s *serviceImpl) BrokenBacklinks(ctx context.Context, opts ...Option) (*BrokenBacklinksResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("broken_backlinks")
+	b.WithMode(ModeSubdomains)
+
+	payload := &BrokenBacklinksResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
@@ -0,0 +1,40 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type BrokenLinksResponse struct {
+	Links []BrokenLink `json:"links"`
+}
+
+// BrokenLink is an outgoing link of the target pointing to a page that
+// returns an error.
+type BrokenLink struct {
+	URLFrom        string    `json:"url_from"`
+	URLTo          string    `json:"url_to"`
+	AhrefsRank     int64     `json:"ahrefs_rank"`
+	DomainToRating int64     `json:"domain_to_rating"`
+	HTTPCode       HTTPCode  `json:"http_code"`
+	Anchor         string    `json:"anchor"`
+	LinkType       LinkType  `json:"link_type"`
+	Nofollow       bool      `json:"nofollow"`
+	FirstSeen      Timestamp `json:"first_seen"`
+	LastVisited    Timestamp `json:"last_visited"`
+	PrevVisited    Timestamp `json:"prev_visited"`
+}
+
+func (s *serviceImpl) BrokenLinks(ctx context.Context, opts ...Option) (*BrokenLinksResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("broken_links")
+	b.WithMode(ModeSubdomains)
+
+	payload := &BrokenLinksResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
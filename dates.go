@@ -0,0 +1,100 @@
+package ahrefs
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oporto723/ahrefs-go/expr"
+)
+
+// defaultDateColumn is the date column assumed for tables without a schema.
+const defaultDateColumn = "date"
+
+// WithDate restricts historical rows, e.g. of the new/lost tables, to a
+// single day. The day is taken in the location of date, the time of day is
+// ignored. Tables without history, such as positions_metrics, reject it.
+func WithDate(date time.Time) Option {
+	return func(rb *requestBuilder) {
+		if date.IsZero() {
+			rb.setErr(errors.New("date: zero date"))
+			return
+		}
+		rb.WithDateRange(date, date)
+	}
+}
+
+// WithDateRange restricts historical rows to days between from and to,
+// inclusive, like WithDate. Days are taken in the location of each time, the
+// time of day is ignored. A zero time leaves the range open on that side.
+func WithDateRange(from, to time.Time) Option {
+	return func(rb *requestBuilder) {
+		if !from.IsZero() && !to.IsZero() && day(from).After(day(to)) {
+			rb.setErr(fmt.Errorf("date range: %s is after %s", from.Format(time.RFC3339), to.Format(time.RFC3339)))
+			return
+		}
+		rb.WithDateRange(from, to)
+	}
+}
+
+func (r *requestBuilder) WithDateRange(from, to time.Time) *requestBuilder {
+	r.dateFrom = from
+	r.dateTo = to
+	return r
+}
+
+// dateExpr renders the date range as a filter on the date column of the
+// table, formatted according to the column type. Tables without a schema are
+// assumed to have a date-only column named "date".
+func (r *requestBuilder) dateExpr() (expr.Expr, error) {
+	if r.dateFrom.IsZero() && r.dateTo.IsZero() {
+		return nil, nil
+	}
+
+	name, typ := defaultDateColumn, TypeDate
+	if table, ok := LookupTable(r.from); ok {
+		c, ok := table.Column(table.Date)
+		if table.Date == "" || !ok || !c.Filterable {
+			return nil, fmt.Errorf("date range: table %s has no date column", table.Name)
+		}
+		name, typ = c.Name, c.Type
+	}
+
+	column := expr.Col(name)
+	from, to := day(r.dateFrom), day(r.dateTo)
+	if typ == TypeTime {
+		// Match whole days: from the start of from to the start of the day
+		// after to.
+		var parts []expr.Expr
+		if !from.IsZero() {
+			parts = append(parts, expr.Gte(column, expr.DateTime(from)))
+		}
+		if !to.IsZero() {
+			parts = append(parts, expr.Lt(column, expr.DateTime(to.AddDate(0, 0, 1))))
+		}
+		if len(parts) == 1 {
+			return parts[0], nil
+		}
+		return expr.And(parts...), nil
+	}
+
+	switch {
+	case from.IsZero():
+		return expr.Lte(column, expr.Date(to)), nil
+	case to.IsZero():
+		return expr.Gte(column, expr.Date(from)), nil
+	case from.Equal(to):
+		return expr.Eq(column, expr.Date(from)), nil
+	default:
+		return expr.And(expr.Gte(column, expr.Date(from)), expr.Lte(column, expr.Date(to))), nil
+	}
+}
+
+// day returns midnight UTC of the day of t in its own location, so that
+// expr.Date renders that day whatever the location of t.
+func day(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
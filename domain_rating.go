@@ -0,0 +1,29 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type DomainRatingResponse struct {
+	Domain DomainRating `json:"domain"`
+}
+
+type DomainRating struct {
+	DomainRating int64 `json:"domain_rating"`
+	AhrefsTop    int64 `json:"ahrefs_top"`
+}
+
+func (s *serviceImpl) DomainRating(ctx context.Context, opts ...Option) (*DomainRatingResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("domain_rating")
+	b.WithMode(ModeSubdomains)
+
+	payload := &DomainRatingResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
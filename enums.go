@@ -0,0 +1,114 @@
+package ahrefs
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// LinkType is the kind of a link.
+type LinkType string
+
+const (
+	LinkTypeHref      LinkType = "href"
+	LinkTypeRedirect  LinkType = "redirect"
+	LinkTypeCanonical LinkType = "canonical"
+	LinkTypeImage     LinkType = "image"
+	LinkTypeFrame     LinkType = "frame"
+	LinkTypeForm      LinkType = "form"
+	LinkTypeAlternate LinkType = "alternate"
+	LinkTypeRSS       LinkType = "rss"
+)
+
+// Valid reports whether t is a link type documented by the API.
+func (t LinkType) Valid() bool {
+	switch t {
+	case LinkTypeHref, LinkTypeRedirect, LinkTypeCanonical, LinkTypeImage,
+		LinkTypeFrame, LinkTypeForm, LinkTypeAlternate, LinkTypeRSS:
+		return true
+	}
+	return false
+}
+
+func (t LinkType) String() string {
+	return string(t)
+}
+
+// MarshalText and UnmarshalText accept unknown link types, so that new values
+// returned by the API do not break decoding; use Valid to check them.
+func (t LinkType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+func (t *LinkType) UnmarshalText(text []byte) error {
+	*t = LinkType(strings.ToLower(string(text)))
+	return nil
+}
+
+// Output is the format of the API responses. The client always requests
+// OutputJSON.
+type Output string
+
+const (
+	OutputJSON Output = "json"
+	OutputXML  Output = "xml"
+	OutputPHP  Output = "php"
+)
+
+// Valid reports whether o is an output format supported by the API.
+func (o Output) Valid() bool {
+	switch o {
+	case OutputJSON, OutputXML, OutputPHP:
+		return true
+	}
+	return false
+}
+
+func (o Output) String() string {
+	return string(o)
+}
+
+func (o Output) MarshalText() ([]byte, error) {
+	if !o.Valid() {
+		return nil, fmt.Errorf("invalid output %q", string(o))
+	}
+	return []byte(o), nil
+}
+
+func (o *Output) UnmarshalText(text []byte) error {
+	output := Output(strings.ToLower(string(text)))
+	if !output.Valid() {
+		return fmt.Errorf("invalid output %q", string(text))
+	}
+	*o = output
+	return nil
+}
+
+// HTTPCode is the HTTP status code of a crawled page. Zero means the page was
+// not fetched, or, for redirects, that the link is not a redirect.
+type HTTPCode int64
+
+// Valid reports whether c is zero or a status code between 100 and 599.
+func (c HTTPCode) Valid() bool {
+	return c == 0 || (c >= 100 && c <= 599)
+}
+
+// IsRedirect reports whether c is a 3xx status code.
+func (c HTTPCode) IsRedirect() bool {
+	return c >= 300 && c < 400
+}
+
+// IsBroken reports whether c is a 4xx or 5xx status code.
+func (c HTTPCode) IsBroken() bool {
+	return c >= 400 && c < 600
+}
+
+// String returns the code and its status text, e.g. "404 Not Found".
+func (c HTTPCode) String() string {
+	code := strconv.FormatInt(int64(c), 10)
+	if text := http.StatusText(int(c)); text != "" {
+		return code + " " + text
+	}
+	return code
+}
@@ -0,0 +1,131 @@
+package ahrefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// ErrorKind classifies API and HTTP failures.
+type ErrorKind int
+
+const (
+	KindUnknown ErrorKind = iota
+	// KindAuth is a missing, invalid or expired token.
+	KindAuth
+	// KindQuota is an exhausted row or unit quota.
+	KindQuota
+	// KindInvalidQuery is a request rejected by the API, e.g. an unknown
+	// column or a malformed filter.
+	KindInvalidQuery
+	// KindRateLimited is a request rejected because too many were sent.
+	KindRateLimited
+	// KindServer is a failure of the API itself.
+	KindServer
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case KindUnknown:
+		return "unknown"
+	case KindAuth:
+		return "auth"
+	case KindQuota:
+		return "quota"
+	case KindInvalidQuery:
+		return "invalid query"
+	case KindRateLimited:
+		return "rate limited"
+	case KindServer:
+		return "server"
+	}
+	return fmt.Sprintf("ErrorKind(%d)", int(k))
+}
+
+// ErrorResponse is returned by Client.Do when the API answers with an error,
+// either as an unexpected HTTP status code or as an error payload flagged by
+// the X-Status header. Use errors.As to inspect it.
+type ErrorResponse struct {
+	// Response is the HTTP response. Its body is already closed.
+	Response *http.Response
+
+	StatusCode int
+	// XStatus is the value of the X-Status header.
+	XStatus string
+	// Message is the error reported by the API, if any.
+	Message string
+	Kind    ErrorKind
+	// URL is the request URL, with the token redacted.
+	URL string
+}
+
+func (e *ErrorResponse) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if e.StatusCode >= 200 && e.StatusCode < 300 {
+		return "api error without message"
+	}
+	return fmt.Sprintf("unexpected status code %d", e.StatusCode)
+}
+
+// maxErrorBody bounds how much of an error payload is read.
+const maxErrorBody = 64 << 10
+
+// newErrorResponse reads the error payload of resp and classifies it.
+func newErrorResponse(resp *http.Response) *ErrorResponse {
+	blob, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	apiErr := struct {
+		Error string `json:"error"`
+	}{}
+	_ = json.Unmarshal(blob, &apiErr)
+	return errorResponse(resp, apiErr.Error)
+}
+
+// errorResponse builds the ErrorResponse of resp for the API error message,
+// without reading the body.
+func errorResponse(resp *http.Response, message string) *ErrorResponse {
+	e := &ErrorResponse{
+		Response:   resp,
+		StatusCode: resp.StatusCode,
+		XStatus:    resp.Header.Get("X-Status"),
+		Message:    message,
+	}
+	if resp.Request != nil && resp.Request.URL != nil {
+		u := *resp.Request.URL
+		e.URL = sanitizeURL(&u).String()
+	}
+	e.Kind = classify(e.StatusCode, e.XStatus, e.Message)
+	return e
+}
+
+func classify(code int, xStatus, message string) ErrorKind {
+	switch {
+	case code == http.StatusUnauthorized, code == http.StatusForbidden:
+		return KindAuth
+	case code == http.StatusPaymentRequired:
+		return KindQuota
+	case code == http.StatusTooManyRequests:
+		return KindRateLimited
+	case code >= 500:
+		return KindServer
+	}
+
+	msg := strings.ToLower(message)
+	switch {
+	case strings.Contains(msg, "token"):
+		return KindAuth
+	case strings.Contains(msg, "too many requests"), strings.Contains(msg, "rate limit"):
+		return KindRateLimited
+	case strings.Contains(msg, "quota"), strings.Contains(msg, "units"),
+		strings.Contains(msg, "rows limit"), strings.Contains(msg, "limit exceeded"),
+		strings.Contains(msg, "subscription"):
+		return KindQuota
+	case xStatus == "error", code >= 400:
+		return KindInvalidQuery
+	}
+	return KindUnknown
+}
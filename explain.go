@@ -0,0 +1,37 @@
+package ahrefs
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// Explanation is the request a call would send, with the token redacted.
+type Explanation struct {
+	Method string
+	URL    string
+	Params url.Values
+	Header http.Header
+}
+
+// ErrDryRun is returned by calls made with WithDryRun once their request is
+// built.
+var ErrDryRun = errors.New("dry run")
+
+// WithDryRun records the request of a call in e instead of sending it. It
+// works with the Service methods, their iterators, Client.Query and
+// Client.Stream, which return ErrDryRun once e is filled in. Invalid options
+// are reported as they would be without a dry run.
+func WithDryRun(e *Explanation) Option {
+	return func(rb *requestBuilder) {
+		rb.explain = e
+	}
+}
+
+func (e *Explanation) record(req *http.Request) {
+	u := sanitizeURL(req.URL)
+	e.Method = req.Method
+	e.URL = u.String()
+	e.Params = u.Query()
+	e.Header = req.Header.Clone()
+}
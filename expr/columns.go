@@ -0,0 +1,97 @@
+package expr
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Columns returns the names of the columns referenced by e, in order of
+// appearance and without duplicates. Columns of raw expressions are found by
+// scanning for identifiers outside string literals that are neither keywords
+// nor function names.
+func Columns(e Expr) []string {
+	var names []string
+	seen := map[string]bool{}
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	collect(e, add)
+	return names
+}
+
+func collect(e Expr, add func(string)) {
+	switch e := e.(type) {
+	case comparison:
+		collectOperand(e.left, add)
+		collectOperand(e.right, add)
+	case logical:
+		for _, e := range e.exprs {
+			collect(e, add)
+		}
+	case not:
+		collect(e.e, add)
+	case function:
+		add(string(e.column))
+	case raw:
+		scanColumns(string(e), add)
+	}
+}
+
+func collectOperand(o Operand, add func(string)) {
+	if c, ok := o.(Column); ok {
+		add(string(c))
+	}
+}
+
+var keywords = map[string]bool{
+	"and":   true,
+	"or":    true,
+	"not":   true,
+	"true":  true,
+	"false": true,
+}
+
+func scanColumns(s string, add func(string)) {
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '"':
+			// Skip the string literal, honoring escapes.
+			i++
+			for i < len(runes) && runes[i] != '"' {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			name := string(runes[start:i])
+			j := i
+			for j < len(runes) && unicode.IsSpace(runes[j]) {
+				j++
+			}
+			if j < len(runes) && runes[j] == '(' {
+				continue
+			}
+			if !keywords[strings.ToLower(name)] {
+				add(name)
+			}
+		case unicode.IsDigit(r):
+			// Skip numbers, including exponents such as 1e10.
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || unicode.IsLetter(runes[i]) || runes[i] == '.') {
+				i++
+			}
+		default:
+			i++
+		}
+	}
+}
@@ -0,0 +1,301 @@
+// Package expr builds the filter expressions accepted by the where and having
+// parameters of the Ahrefs API.
+//
+// Expressions are checked when rendered, so a misspelled column or an invalid
+// regular expression is reported before the request is sent:
+//
+//	e := expr.And(
+//		expr.Gt(expr.Col("domain_rating"), expr.Int(10)),
+//		expr.Eq(expr.Col("country"), expr.String("us")),
+//	)
+//	s, err := expr.Render(e) // domain_rating>10 and country="us"
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Expr is a boolean expression.
+type Expr interface {
+	render(b *strings.Builder) error
+}
+
+// Operand is either a column or a literal value.
+type Operand interface {
+	renderOperand(b *strings.Builder) error
+}
+
+// Render validates e and returns its textual form.
+func Render(e Expr) (string, error) {
+	if e == nil {
+		return "", errors.New("expr: nil expression")
+	}
+	var b strings.Builder
+	if err := e.render(&b); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+var columnRe = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Column is a reference to a table column.
+type Column string
+
+// Col returns a reference to the named column.
+func Col(name string) Column {
+	return Column(name)
+}
+
+func (c Column) renderOperand(b *strings.Builder) error {
+	if !columnRe.MatchString(string(c)) {
+		return fmt.Errorf("expr: invalid column name %q", string(c))
+	}
+	b.WriteString(string(c))
+	return nil
+}
+
+type stringValue string
+
+// String returns a quoted string literal.
+func String(s string) Operand {
+	return stringValue(s)
+}
+
+func (v stringValue) renderOperand(b *strings.Builder) error {
+	writeQuoted(b, string(v))
+	return nil
+}
+
+type intValue int64
+
+// Int returns an integer literal.
+func Int(i int64) Operand {
+	return intValue(i)
+}
+
+func (v intValue) renderOperand(b *strings.Builder) error {
+	b.WriteString(strconv.FormatInt(int64(v), 10))
+	return nil
+}
+
+type floatValue float64
+
+// Float returns a floating point literal.
+func Float(f float64) Operand {
+	return floatValue(f)
+}
+
+func (v floatValue) renderOperand(b *strings.Builder) error {
+	f := float64(v)
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return fmt.Errorf("expr: invalid number %v", f)
+	}
+	b.WriteString(strconv.FormatFloat(f, 'f', -1, 64))
+	return nil
+}
+
+type boolValue bool
+
+// Bool returns a boolean literal.
+func Bool(v bool) Operand {
+	return boolValue(v)
+}
+
+func (v boolValue) renderOperand(b *strings.Builder) error {
+	b.WriteString(strconv.FormatBool(bool(v)))
+	return nil
+}
+
+type timeValue struct {
+	t      time.Time
+	layout string
+}
+
+// Date returns a date literal such as "2020-12-31". The time is converted to
+// UTC first.
+func Date(t time.Time) Operand {
+	return timeValue{t: t, layout: "2006-01-02"}
+}
+
+// DateTime returns a date and time literal such as "2020-12-31 23:59:59". The
+// time is converted to UTC first.
+func DateTime(t time.Time) Operand {
+	return timeValue{t: t, layout: "2006-01-02 15:04:05"}
+}
+
+func (v timeValue) renderOperand(b *strings.Builder) error {
+	if v.t.IsZero() {
+		return errors.New("expr: zero time")
+	}
+	writeQuoted(b, v.t.UTC().Format(v.layout))
+	return nil
+}
+
+type raw string
+
+// Raw returns an expression rendered verbatim. It is not validated, and is
+// parenthesized when combined with other expressions.
+func Raw(s string) Expr {
+	return raw(s)
+}
+
+func (r raw) render(b *strings.Builder) error {
+	if r == "" {
+		return errors.New("expr: empty raw expression")
+	}
+	b.WriteString(string(r))
+	return nil
+}
+
+type comparison struct {
+	op          string
+	left, right Operand
+}
+
+// Eq is true when left and right are equal.
+func Eq(left, right Operand) Expr { return comparison{"=", left, right} }
+
+// Ne is true when left and right differ.
+func Ne(left, right Operand) Expr { return comparison{"<>", left, right} }
+
+// Lt is true when left is less than right.
+func Lt(left, right Operand) Expr { return comparison{"<", left, right} }
+
+// Lte is true when left is less than or equal to right.
+func Lte(left, right Operand) Expr { return comparison{"<=", left, right} }
+
+// Gt is true when left is greater than right.
+func Gt(left, right Operand) Expr { return comparison{">", left, right} }
+
+// Gte is true when left is greater than or equal to right.
+func Gte(left, right Operand) Expr { return comparison{">=", left, right} }
+
+func (c comparison) render(b *strings.Builder) error {
+	if c.left == nil || c.right == nil {
+		return fmt.Errorf("expr: missing operand for %q", c.op)
+	}
+	if err := c.left.renderOperand(b); err != nil {
+		return err
+	}
+	b.WriteString(c.op)
+	return c.right.renderOperand(b)
+}
+
+type logical struct {
+	op    string
+	exprs []Expr
+}
+
+// And is true when all of exprs are true.
+func And(exprs ...Expr) Expr { return logical{"and", exprs} }
+
+// Or is true when any of exprs is true.
+func Or(exprs ...Expr) Expr { return logical{"or", exprs} }
+
+func (l logical) render(b *strings.Builder) error {
+	if len(l.exprs) == 0 {
+		return fmt.Errorf("expr: %s needs at least one expression", l.op)
+	}
+	for i, e := range l.exprs {
+		if i > 0 {
+			b.WriteString(" " + l.op + " ")
+		}
+		if err := renderNested(b, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type not struct {
+	e Expr
+}
+
+// Not is true when e is false.
+func Not(e Expr) Expr { return not{e} }
+
+func (n not) render(b *strings.Builder) error {
+	if n.e == nil {
+		return errors.New("expr: nil expression")
+	}
+	b.WriteString("not(")
+	if err := n.e.render(b); err != nil {
+		return err
+	}
+	b.WriteString(")")
+	return nil
+}
+
+type function struct {
+	name   string
+	column Column
+	arg    string
+}
+
+// Subdomain is true when the host in column is s or one of its subdomains.
+func Subdomain(column Column, s string) Expr {
+	return function{"subdomain", column, s}
+}
+
+// Regex is true when column matches the regular expression pattern.
+func Regex(column Column, pattern string) Expr {
+	return function{"regex", column, pattern}
+}
+
+func (f function) render(b *strings.Builder) error {
+	switch f.name {
+	case "subdomain":
+		if f.arg == "" {
+			return errors.New("expr: subdomain needs a domain")
+		}
+	case "regex":
+		if _, err := regexp.Compile(f.arg); err != nil {
+			return fmt.Errorf("expr: invalid regex: %w", err)
+		}
+	}
+	b.WriteString(f.name + "(")
+	if err := f.column.renderOperand(b); err != nil {
+		return err
+	}
+	b.WriteString(",")
+	writeQuoted(b, f.arg)
+	b.WriteString(")")
+	return nil
+}
+
+// renderNested renders e, wrapping it in parentheses when it is itself an
+// and/or expression or a raw one so that operator precedence is preserved.
+func renderNested(b *strings.Builder, e Expr) error {
+	if e == nil {
+		return errors.New("expr: nil expression")
+	}
+	switch e.(type) {
+	case logical, raw:
+	default:
+		return e.render(b)
+	}
+	b.WriteString("(")
+	if err := e.render(b); err != nil {
+		return err
+	}
+	b.WriteString(")")
+	return nil
+}
+
+func writeQuoted(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+}
@@ -0,0 +1,191 @@
+package expr_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"github.com/oporto723/ahrefs-go/expr"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2020, 12, 31, 23, 59, 58, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr expr.Expr
+		want string
+	}{{
+		name: "string",
+		expr: expr.Eq(expr.Col("country"), expr.String("us")),
+		want: `country="us"`,
+	}, {
+		name: "quoting",
+		expr: expr.Eq(expr.Col("anchor"), expr.String(`say "hi" \o/`)),
+		want: `anchor="say \"hi\" \\o/"`,
+	}, {
+		name: "numbers",
+		expr: expr.And(
+			expr.Gt(expr.Col("domain_rating"), expr.Int(10)),
+			expr.Lte(expr.Col("traffic"), expr.Float(1.5)),
+		),
+		want: `domain_rating>10 and traffic<=1.5`,
+	}, {
+		name: "bool",
+		expr: expr.Ne(expr.Col("nofollow"), expr.Bool(true)),
+		want: `nofollow<>true`,
+	}, {
+		name: "dates",
+		expr: expr.Or(
+			expr.Gte(expr.Col("date"), expr.Date(date)),
+			expr.Lt(expr.Col("first_seen"), expr.DateTime(date)),
+		),
+		want: `date>="2020-12-31" or first_seen<"2020-12-31 23:59:58"`,
+	}, {
+		name: "columns",
+		expr: expr.Gte(expr.Col("backlinks"), expr.Col("backlinks_dofollow")),
+		want: `backlinks>=backlinks_dofollow`,
+	}, {
+		name: "precedence",
+		expr: expr.And(
+			expr.Gt(expr.Col("domain_rating"), expr.Int(10)),
+			expr.Or(
+				expr.Eq(expr.Col("country"), expr.String("us")),
+				expr.Eq(expr.Col("country"), expr.String("gb")),
+			),
+		),
+		want: `domain_rating>10 and (country="us" or country="gb")`,
+	}, {
+		name: "not",
+		expr: expr.Not(expr.Subdomain(expr.Col("url_from"), "blogspot.com")),
+		want: `not(subdomain(url_from,"blogspot.com"))`,
+	}, {
+		name: "regex",
+		expr: expr.Regex(expr.Col("anchor"), `^(buy|cheap) .*`),
+		want: `regex(anchor,"^(buy|cheap) .*")`,
+	}, {
+		name: "raw",
+		expr: expr.Raw(`country="us" or country="gb"`),
+		want: `country="us" or country="gb"`,
+	}, {
+		name: "nested raw",
+		expr: expr.And(
+			expr.Raw(`country="us" or country="gb"`),
+			expr.Eq(expr.Col("dofollow"), expr.Bool(true)),
+		),
+		want: `(country="us" or country="gb") and dofollow=true`,
+	}}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			c := qt.New(t)
+
+			got, err := expr.Render(test.expr)
+			c.Assert(err, qt.IsNil)
+			c.Assert(got, qt.Equals, test.want)
+		})
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		expr    expr.Expr
+		wantErr string
+	}{{
+		name:    "nil",
+		expr:    nil,
+		wantErr: "expr: nil expression",
+	}, {
+		name:    "column",
+		expr:    expr.Eq(expr.Col("Domain Rating"), expr.Int(1)),
+		wantErr: `expr: invalid column name "Domain Rating"`,
+	}, {
+		name:    "operand",
+		expr:    expr.Eq(expr.Col("country"), nil),
+		wantErr: `expr: missing operand for "="`,
+	}, {
+		name:    "empty and",
+		expr:    expr.And(),
+		wantErr: "expr: and needs at least one expression",
+	}, {
+		name:    "nested nil",
+		expr:    expr.Or(expr.Eq(expr.Col("ugc"), expr.Bool(true)), nil),
+		wantErr: "expr: nil expression",
+	}, {
+		name:    "nan",
+		expr:    expr.Gt(expr.Col("traffic"), expr.Float(math.NaN())),
+		wantErr: "expr: invalid number NaN",
+	}, {
+		name:    "zero date",
+		expr:    expr.Gt(expr.Col("date"), expr.Date(time.Time{})),
+		wantErr: "expr: zero time",
+	}, {
+		name:    "regex",
+		expr:    expr.Regex(expr.Col("anchor"), "(unclosed"),
+		wantErr: "expr: invalid regex: .*",
+	}, {
+		name:    "subdomain",
+		expr:    expr.Subdomain(expr.Col("url_from"), ""),
+		wantErr: "expr: subdomain needs a domain",
+	}, {
+		name:    "raw",
+		expr:    expr.Raw(""),
+		wantErr: "expr: empty raw expression",
+	}}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			c := qt.New(t)
+
+			_, err := expr.Render(test.expr)
+			c.Assert(err, qt.ErrorMatches, test.wantErr)
+		})
+	}
+}
+
+func TestColumns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr expr.Expr
+		want []string
+	}{{
+		name: "typed",
+		expr: expr.And(
+			expr.Gt(expr.Col("domain_rating"), expr.Int(10)),
+			expr.Not(expr.Subdomain(expr.Col("refdomain"), "blogspot.com")),
+			expr.Gte(expr.Col("backlinks"), expr.Col("domain_rating")),
+		),
+		want: []string{"domain_rating", "refdomain", "backlinks"},
+	}, {
+		name: "raw",
+		expr: expr.Raw(`country="us and not_a_column" AND domain_rating>1e3 or not(subdomain(url_from, "x\"y"))`),
+		want: []string{"country", "domain_rating", "url_from"},
+	}, {
+		name: "literals",
+		expr: expr.Eq(expr.String("a"), expr.Int(1)),
+		want: nil,
+	}}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			c := qt.New(t)
+
+			c.Assert(expr.Columns(test.expr), qt.DeepEquals, test.want)
+		})
+	}
+}
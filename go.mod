@@ -2,4 +2,7 @@ module github.com/oporto723/ahrefs-go
 
 go 1.15
 
-require github.com/frankban/quicktest v1.11.3
+require (
+	github.com/frankban/quicktest v1.11.3
+	golang.org/x/net v0.0.0-20210405180319-a5a99cb37ef4
+)
@@ -0,0 +1,150 @@
+package ahrefs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// rowIterator holds the state shared by the row iterators. The request is
+// sent when the iterator is created, and rows are decoded one at a time as
+// Next is called.
+type rowIterator struct {
+	ctx  context.Context
+	rows *rowReader
+	resp *http.Response
+	err  error
+	done bool
+}
+
+func (c *Client) iterate(ctx context.Context, b *requestBuilder) rowIterator {
+	rows, resp, err := c.openRows(ctx, b)
+	return rowIterator{
+		ctx:  ctx,
+		rows: rows,
+		resp: resp,
+		err:  err,
+		done: err != nil,
+	}
+}
+
+// next decodes the next row into v. It returns false when there are no more
+// rows, the context is done, or an error occurred.
+func (it *rowIterator) next(v interface{}) bool {
+	if it.done {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.fail(err)
+		return false
+	}
+
+	row, err := it.rows.next()
+	if err == io.EOF {
+		_ = it.Close()
+		return false
+	}
+	if err == nil {
+		err = json.Unmarshal(row, v)
+	}
+	if err != nil {
+		it.fail(err)
+		return false
+	}
+	return true
+}
+
+func (it *rowIterator) fail(err error) {
+	it.err = err
+	_ = it.Close()
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *rowIterator) Err() error {
+	return it.err
+}
+
+// Response returns the HTTP response of the iterated request.
+func (it *rowIterator) Response() *http.Response {
+	return it.resp
+}
+
+// Close stops the iteration and releases the response. It is called
+// implicitly once Next returns false, and may be called at any time to stop
+// early.
+func (it *rowIterator) Close() error {
+	it.done = true
+	if it.rows == nil {
+		return nil
+	}
+	rows := it.rows
+	it.rows = nil
+	return rows.close()
+}
+
+// RefpageIterator iterates over backlinks.
+type RefpageIterator struct {
+	rowIterator
+	row *Refpage
+}
+
+// Next advances to the next row, and reports whether there is one.
+func (it *RefpageIterator) Next() bool {
+	row := &Refpage{}
+	if !it.next(row) {
+		it.row = nil
+		return false
+	}
+	it.row = row
+	return true
+}
+
+// Row returns the current row.
+func (it *RefpageIterator) Row() *Refpage {
+	return it.row
+}
+
+// PageIterator iterates over pages.
+type PageIterator struct {
+	rowIterator
+	row *Page
+}
+
+// Next advances to the next row, and reports whether there is one.
+func (it *PageIterator) Next() bool {
+	row := &Page{}
+	if !it.next(row) {
+		it.row = nil
+		return false
+	}
+	it.row = row
+	return true
+}
+
+// Row returns the current row.
+func (it *PageIterator) Row() *Page {
+	return it.row
+}
+
+// ReferringDomainIterator iterates over referring domains.
+type ReferringDomainIterator struct {
+	rowIterator
+	row *ReferringDomain
+}
+
+// Next advances to the next row, and reports whether there is one.
+func (it *ReferringDomainIterator) Next() bool {
+	row := &ReferringDomain{}
+	if !it.next(row) {
+		it.row = nil
+		return false
+	}
+	it.row = row
+	return true
+}
+
+// Row returns the current row.
+func (it *ReferringDomainIterator) Row() *ReferringDomain {
+	return it.row
+}
@@ -0,0 +1,26 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+// LinkedAnchorsResponse lists the anchors of the outgoing links of the
+// target. Rows share the shape of the anchors table.
+type LinkedAnchorsResponse struct {
+	Anchors []Anchor `json:"anchors"`
+}
+
+func (s *serviceImpl) LinkedAnchors(ctx context.Context, opts ...Option) (*LinkedAnchorsResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("linked_anchors")
+	b.WithMode(ModeSubdomains)
+
+	payload := &LinkedAnchorsResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
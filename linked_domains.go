@@ -0,0 +1,40 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type LinkedDomainsResponse struct {
+	LinkedDomains []LinkedDomain     `json:"domains"`
+	Stats         LinkedDomainsStats `json:"stats"`
+}
+
+type LinkedDomain struct {
+	DomainFrom     string    `json:"domain_from"`
+	DomainTo       string    `json:"domain_to"`
+	DomainToRating int64     `json:"domain_to_rating"`
+	Links          int64     `json:"links"`
+	UniquePages    int64     `json:"unique_pages"`
+	FirstSeen      Timestamp `json:"first_seen"`
+	LastVisited    Timestamp `json:"last_visited"`
+}
+
+type LinkedDomainsStats struct {
+	LinkedDomains int64 `json:"linked_domains"`
+	Links         int64 `json:"links"`
+}
+
+func (s *serviceImpl) LinkedDomains(ctx context.Context, opts ...Option) (*LinkedDomainsResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("linked_domains")
+	b.WithMode(ModeSubdomains)
+
+	payload := &LinkedDomainsResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
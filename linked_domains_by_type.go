@@ -0,0 +1,58 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type LinkedDomainsByTypeResponse struct {
+	LinkedDomainsByType []LinkedDomainByType     `json:"domains"`
+	Stats               LinkedDomainsByTypeStats `json:"stats"`
+}
+
+type LinkedDomainByType struct {
+	DomainFrom     string    `json:"domain_from"`
+	DomainTo       string    `json:"domain_to"`
+	DomainToRating int64     `json:"domain_to_rating"`
+	Links          int64     `json:"links"`
+	LinksDofollow  int64     `json:"links_dofollow"`
+	UniquePages    int64     `json:"unique_pages"`
+	FirstSeen      Timestamp `json:"first_seen"`
+	LastVisited    Timestamp `json:"last_visited"`
+	Traffic        float64   `json:"traffic"`
+}
+
+type LinkedDomainsByTypeStats struct {
+	LinkedDomains      int64 `json:"linked_domains"`
+	MaxLinks           int64 `json:"max_links"`
+	MaxLinksDofollow   int64 `json:"max_links_dofollow"`
+	TotalLinks         int64 `json:"total_links"`
+	TotalLinksDofollow int64 `json:"total_links_dofollow"`
+	All                int64 `json:"all"`
+	Text               int64 `json:"text"`
+	Image              int64 `json:"image"`
+	Nofollow           int64 `json:"nofollow"`
+	Ugc                int64 `json:"ugc"`
+	Sponsored          int64 `json:"sponsored"`
+	Dofollow           int64 `json:"dofollow"`
+	Redirect           int64 `json:"redirect"`
+	Canonical          int64 `json:"canonical"`
+	Gov                int64 `json:"gov"`
+	Edu                int64 `json:"edu"`
+	RSS                int64 `json:"rss"`
+	Alternate          int64 `json:"alternate"`
+}
+
+func (s *serviceImpl) LinkedDomainsByType(ctx context.Context, opts ...Option) (*LinkedDomainsByTypeResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("linked_domains_by_type")
+	b.WithMode(ModeSubdomains)
+
+	payload := &LinkedDomainsByTypeResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
@@ -0,0 +1,41 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type MetricsResponse struct {
+	Metrics Metrics `json:"metrics"`
+}
+
+type Metrics struct {
+	Backlinks  int64 `json:"backlinks"`
+	Refpages   int64 `json:"refpages"`
+	Pages      int64 `json:"pages"`
+	ValidPages int64 `json:"valid_pages"`
+	Text       int64 `json:"text"`
+	Image      int64 `json:"image"`
+	Nofollow   int64 `json:"nofollow"`
+	Dofollow   int64 `json:"dofollow"`
+	Redirect   int64 `json:"redirect"`
+	Canonical  int64 `json:"canonical"`
+	Gov        int64 `json:"gov"`
+	Edu        int64 `json:"edu"`
+	RSS        int64 `json:"rss"`
+	Alternate  int64 `json:"alternate"`
+}
+
+func (s *serviceImpl) Metrics(ctx context.Context, opts ...Option) (*MetricsResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("metrics")
+	b.WithMode(ModeSubdomains)
+
+	payload := &MetricsResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
@@ -0,0 +1,48 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type MetricsExtendedResponse struct {
+	Metrics MetricsExtended `json:"metrics"`
+}
+
+type MetricsExtended struct {
+	Backlinks         int64 `json:"backlinks"`
+	Refpages          int64 `json:"refpages"`
+	Pages             int64 `json:"pages"`
+	ValidPages        int64 `json:"valid_pages"`
+	Text              int64 `json:"text"`
+	Image             int64 `json:"image"`
+	Nofollow          int64 `json:"nofollow"`
+	Dofollow          int64 `json:"dofollow"`
+	Redirect          int64 `json:"redirect"`
+	Canonical         int64 `json:"canonical"`
+	Gov               int64 `json:"gov"`
+	Edu               int64 `json:"edu"`
+	RSS               int64 `json:"rss"`
+	Alternate         int64 `json:"alternate"`
+	HTMLPages         int64 `json:"html_pages"`
+	LinksInternal     int64 `json:"links_internal"`
+	LinksExternal     int64 `json:"links_external"`
+	RefDomains        int64 `json:"refdomains"`
+	RefClassC         int64 `json:"refclass_c"`
+	RefIPs            int64 `json:"refips"`
+	LinkedRootDomains int64 `json:"linked_root_domains"`
+}
+
+func (s *serviceImpl) MetricsExtended(ctx context.Context, opts ...Option) (*MetricsExtendedResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("metrics_extended")
+	b.WithMode(ModeSubdomains)
+
+	payload := &MetricsExtendedResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
@@ -0,0 +1,51 @@
+package ahrefs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Mode defines how the target is matched.
+type Mode string
+
+const (
+	// ModeExact matches the exact URL.
+	ModeExact Mode = "exact"
+	// ModeDomain matches all URLs of the domain, e.g. ahrefs.com/*.
+	ModeDomain Mode = "domain"
+	// ModeSubdomains matches all URLs of the domain and its subdomains, e.g.
+	// *ahrefs.com/*.
+	ModeSubdomains Mode = "subdomains"
+	// ModePrefix matches all URLs starting with the target, e.g.
+	// ahrefs.com/blog/*.
+	ModePrefix Mode = "prefix"
+)
+
+// Valid reports whether m is a mode supported by the API.
+func (m Mode) Valid() bool {
+	switch m {
+	case ModeExact, ModeDomain, ModeSubdomains, ModePrefix:
+		return true
+	}
+	return false
+}
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+func (m Mode) MarshalText() ([]byte, error) {
+	if !m.Valid() {
+		return nil, fmt.Errorf("invalid mode %q", string(m))
+	}
+	return []byte(m), nil
+}
+
+func (m *Mode) UnmarshalText(text []byte) error {
+	mode := Mode(strings.ToLower(string(text)))
+	if !mode.Valid() {
+		return fmt.Errorf("invalid mode %q", string(text))
+	}
+	*m = mode
+	return nil
+}
@@ -11,18 +11,18 @@ type PagesResponse struct {
 }
 
 type Page struct {
-	URL             string `json:"url"`
-	AhrefsRank      int64  `json:"ahrefs_rank"`
-	FirstSeen       string `json:"first_seen"`
-	LastVisited     string `json:"last_visited"`
-	HTTPCode        int64  `json:"http_code"`
-	Size            int64  `json:"size"`
-	LinksInternal   int64  `json:"links_internal"`
-	LinksExternal   int64  `json:"links_external"`
-	Encoding        string `json:"encoding"`
-	Title           string `json:"title"`
-	RedirectURL     string `json:"redirect_url"`
-	ContentEncoding string `json:"content_encoding"`
+	URL             string    `json:"url"`
+	AhrefsRank      int64     `json:"ahrefs_rank"`
+	FirstSeen       Timestamp `json:"first_seen"`
+	LastVisited     Timestamp `json:"last_visited"`
+	HTTPCode        HTTPCode  `json:"http_code"`
+	Size            int64     `json:"size"`
+	LinksInternal   int64     `json:"links_internal"`
+	LinksExternal   int64     `json:"links_external"`
+	Encoding        string    `json:"encoding"`
+	Title           string    `json:"title"`
+	RedirectURL     string    `json:"redirect_url"`
+	ContentEncoding string    `json:"content_encoding"`
 }
 
 type PagesStats struct {
@@ -30,9 +30,7 @@ type PagesStats struct {
 }
 
 func (s *serviceImpl) Pages(ctx context.Context, opts ...Option) (*PagesResponse, *http.Response, error) {
-	b := s.client.requestBuilder(ctx, opts)
-	b.WithFrom("pages")
-	b.WithMode("subdomains")
+	b := s.pagesBuilder(ctx, opts)
 
 	payload := &PagesResponse{}
 	resp, err := s.client.Do(ctx, b, payload)
@@ -42,3 +40,18 @@ func (s *serviceImpl) Pages(ctx context.Context, opts ...Option) (*PagesResponse
 
 	return payload, resp, err
 }
+
+func (s *serviceImpl) PagesIter(ctx context.Context, opts ...Option) *PageIterator {
+	b := s.pagesBuilder(ctx, opts)
+
+	return &PageIterator{rowIterator: s.client.iterate(ctx, b)}
+}
+
+// pagesBuilder sets the defaults shared by Pages and PagesIter.
+func (s *serviceImpl) pagesBuilder(ctx context.Context, opts []Option) *requestBuilder {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("pages")
+	b.WithMode(ModeSubdomains)
+
+	return b
+}
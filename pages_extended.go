@@ -0,0 +1,38 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type PagesExtendedResponse struct {
+	Pages []PageExtended `json:"pages"`
+	Stats PagesStats     `json:"stats"`
+}
+
+// PageExtended is a row of the pages_extended table. It adds link counts and
+// crawl details to the columns of the pages table.
+type PageExtended struct {
+	Page
+	IP                string    `json:"ip"`
+	Language          string    `json:"language"`
+	PrevVisited       Timestamp `json:"prev_visited"`
+	Backlinks         int64     `json:"backlinks"`
+	BacklinksDofollow int64     `json:"backlinks_dofollow"`
+	ReferringPages    int64     `json:"refpages"`
+	RefDomains        int64     `json:"refdomains"`
+}
+
+func (s *serviceImpl) PagesExtended(ctx context.Context, opts ...Option) (*PagesExtendedResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("pages_extended")
+	b.WithMode(ModeSubdomains)
+
+	payload := &PagesExtendedResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
@@ -0,0 +1,34 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type PagesInfoResponse struct {
+	Pages []PageInfo `json:"pages"`
+}
+
+// PageInfo is a row of the pages_info table. It adds crawl details to the
+// columns of the pages table.
+type PageInfo struct {
+	Page
+	IP          string    `json:"ip"`
+	Language    string    `json:"language"`
+	PrevVisited Timestamp `json:"prev_visited"`
+	Canonical   string    `json:"canonical"`
+}
+
+func (s *serviceImpl) PagesInfo(ctx context.Context, opts ...Option) (*PagesInfoResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("pages_info")
+	b.WithMode(ModeSubdomains)
+
+	payload := &PagesInfoResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
@@ -24,8 +24,8 @@ type PositionMetrics struct {
 func (s *serviceImpl) PositionMetrics(ctx context.Context, opts ...Option) (*PositionMetricsResponse, *http.Response, error) {
 	b := s.client.requestBuilder(ctx, opts)
 	b.WithFrom("positions_metrics")
-	b.WithMode("subdomains")
-	b.WithWhere("country=\"us\"")
+	b.WithMode(ModeSubdomains)
+	b.WithDefaultCountry("us")
 
 	payload := &PositionMetricsResponse{}
 	resp, err := s.client.Do(ctx, b, payload)
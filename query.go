@@ -0,0 +1,99 @@
+package ahrefs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// QueryResponse holds the rows of any table.
+type QueryResponse struct {
+	// Rows are the rows of the table, keyed by column name. Tables returning
+	// a single object, such as metrics, have one row.
+	Rows []map[string]interface{}
+
+	// Raw is the whole payload, keyed by section, e.g. "refdomains",
+	// "stats" or "tlds".
+	Raw map[string]json.RawMessage
+
+	rows json.RawMessage
+}
+
+// Decode decodes the rows into v, which must be a pointer to a slice. Struct
+// fields are mapped to columns using json tags, as in the typed responses.
+func (r *QueryResponse) Decode(v interface{}) error {
+	return json.Unmarshal(r.rows, v)
+}
+
+// Query queries any table of the API, including tables without a dedicated
+// Service method. Like the Service methods, the target is matched in
+// subdomains mode unless WithMode is used. Columns are not checked against the
+// table schema, so that columns the library does not know about yet can be
+// queried.
+func (c *Client) Query(ctx context.Context, table string, opts ...Option) (*QueryResponse, *http.Response, error) {
+	b := c.requestBuilder(ctx, opts)
+	b.WithFrom(table)
+	b.WithMode(ModeSubdomains)
+	b.noValidation = true
+
+	raw := map[string]json.RawMessage{}
+	resp, err := c.Do(ctx, b, &raw)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	rows, err := findRows(b.from, raw)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	payload := &QueryResponse{Raw: raw, rows: rows}
+	if err := payload.Decode(&payload.Rows); err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, nil
+}
+
+// findRows returns the rows of table as a JSON array. The section holding the
+// rows is taken from the table schema, or guessed for unknown tables: it is
+// the only array of the payload, or else its only section besides stats.
+func findRows(table string, raw map[string]json.RawMessage) (json.RawMessage, error) {
+	var rows json.RawMessage
+	if schema, ok := LookupTable(table); ok && schema.Rows != "" {
+		rows = raw[schema.Rows]
+	} else {
+		var arrays, others []string
+		for key, value := range raw {
+			if isJSONArray(value) {
+				arrays = append(arrays, key)
+			} else if key != "stats" {
+				others = append(others, key)
+			}
+		}
+		switch {
+		case len(arrays) == 1:
+			rows = raw[arrays[0]]
+		case len(arrays) == 0 && len(others) == 1:
+			rows = raw[others[0]]
+		default:
+			return nil, fmt.Errorf("cannot find the rows of table %q", table)
+		}
+	}
+
+	switch {
+	case rows == nil, bytes.Equal(bytes.TrimSpace(rows), []byte("null")):
+		return json.RawMessage("[]"), nil
+	case isJSONArray(rows):
+		return rows, nil
+	default:
+		return json.RawMessage(append(append([]byte("["), rows...), ']')), nil
+	}
+}
+
+func isJSONArray(value json.RawMessage) bool {
+	value = bytes.TrimSpace(value)
+	return len(value) > 0 && value[0] == '['
+}
@@ -23,12 +23,7 @@ type ReferringDomainsStats struct {
 }
 
 func (s *serviceImpl) ReferringDomains(ctx context.Context, opts ...Option) (*ReferringDomainsResponse, *http.Response, error) {
-	b := s.client.requestBuilder(ctx, opts)
-	b.WithColumns("refdomain,domain_rating,backlinks")
-	b.WithFrom("refdomains")
-	b.WithMode("subdomains")
-	b.WithWhere("country=\"us\"")
-	b.WithOrderBy("domain_rating:desc")
+	b := s.referringDomainsBuilder(ctx, opts)
 
 	payload := &ReferringDomainsResponse{}
 	resp, err := s.client.Do(ctx, b, payload)
@@ -38,3 +33,21 @@ func (s *serviceImpl) ReferringDomains(ctx context.Context, opts ...Option) (*Re
 
 	return payload, resp, err
 }
+
+func (s *serviceImpl) ReferringDomainsIter(ctx context.Context, opts ...Option) *ReferringDomainIterator {
+	b := s.referringDomainsBuilder(ctx, opts)
+
+	return &ReferringDomainIterator{rowIterator: s.client.iterate(ctx, b)}
+}
+
+// referringDomainsBuilder sets the defaults shared by ReferringDomains and
+// ReferringDomainsIter.
+func (s *serviceImpl) referringDomainsBuilder(ctx context.Context, opts []Option) *requestBuilder {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithColumns("refdomain,domain_rating,backlinks")
+	b.WithFrom("refdomains")
+	b.WithMode(ModeSubdomains)
+	b.WithOrderBy("domain_rating:desc")
+
+	return b
+}
@@ -3,6 +3,8 @@ package ahrefs
 import (
 	"context"
 	"net/http"
+
+	"github.com/oporto723/ahrefs-go/expr"
 )
 
 type ReferringDomainsByTypeResponse struct {
@@ -12,17 +14,17 @@ type ReferringDomainsByTypeResponse struct {
 }
 
 type ReferringDomainByType struct {
-	RefDomain          string  `json:"refdomain"`
-	ReferringDomainTop string  `json:"refdomain_top"`
-	Backlinks          int64   `json:"backlinks"`
-	BacklinksDofollow  int64   `json:"backlinks_dofollow"`
-	ReferringPages     int64   `json:"refpages"`
-	FirstSeen          string  `json:"first_seen"`
-	LastVisited        string  `json:"last_visited"`
-	DomainRating       int64   `json:"domain_rating"`
-	Traffic            float64 `json:"traffic"`
-	LinkedDomains      int64   `json:"linked_domains"`
-	RefDomains         int64   `json:"refdomains"`
+	RefDomain          string    `json:"refdomain"`
+	ReferringDomainTop string    `json:"refdomain_top"`
+	Backlinks          int64     `json:"backlinks"`
+	BacklinksDofollow  int64     `json:"backlinks_dofollow"`
+	ReferringPages     int64     `json:"refpages"`
+	FirstSeen          Timestamp `json:"first_seen"`
+	LastVisited        Timestamp `json:"last_visited"`
+	DomainRating       int64     `json:"domain_rating"`
+	Traffic            float64   `json:"traffic"`
+	LinkedDomains      int64     `json:"linked_domains"`
+	RefDomains         int64     `json:"refdomains"`
 }
 
 type ReferringDomainsByTypeStats struct {
@@ -57,8 +59,8 @@ type TLD struct {
 func (s *serviceImpl) ReferringDomainsByType(ctx context.Context, opts ...Option) (*ReferringDomainsByTypeResponse, *http.Response, error) {
 	b := s.client.requestBuilder(ctx, opts)
 	b.WithFrom("refdomains_by_type")
-	b.WithMode("subdomains")
-	b.WithWhere("dofollow=true")
+	b.WithMode(ModeSubdomains)
+	b.WithDefaultWhere(expr.Eq(expr.Col("dofollow"), expr.Bool(true)))
 	b.WithOrderBy("domain_rating:desc")
 
 	payload := &ReferringDomainsByTypeResponse{}
@@ -0,0 +1,33 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type ReferringDomainsNewLostResponse struct {
+	ReferringDomains []ReferringDomainNewLost `json:"refdomains"`
+}
+
+type ReferringDomainNewLost struct {
+	// Type is either "new" or "lost".
+	Type            string    `json:"type"`
+	Date            Timestamp `json:"date"`
+	ReferringDomain string    `json:"refdomain"`
+	DomainRating    int64     `json:"domain_rating"`
+	Backlinks       int64     `json:"backlinks"`
+}
+
+func (s *serviceImpl) ReferringDomainsNewLost(ctx context.Context, opts ...Option) (*ReferringDomainsNewLostResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("refdomains_new_lost")
+	b.WithMode(ModeSubdomains)
+
+	payload := &ReferringDomainsNewLostResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
@@ -0,0 +1,32 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type ReferringDomainsNewLostCountersResponse struct {
+	Counts []ReferringDomainsNewLostCounter `json:"counts"`
+}
+
+// ReferringDomainsNewLostCounter holds the number of new and lost referring
+// domains for a single date.
+type ReferringDomainsNewLostCounter struct {
+	Date Timestamp `json:"date"`
+	New  int64     `json:"new"`
+	Lost int64     `json:"lost"`
+}
+
+func (s *serviceImpl) ReferringDomainsNewLostCounters(ctx context.Context, opts ...Option) (*ReferringDomainsNewLostCountersResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("refdomains_new_lost_counters")
+	b.WithMode(ModeSubdomains)
+
+	payload := &ReferringDomainsNewLostCountersResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
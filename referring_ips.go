@@ -0,0 +1,94 @@
+package ahrefs
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sort"
+)
+
+type ReferringIPsResponse struct {
+	ReferringIPs []ReferringIP     `json:"refips"`
+	Stats        ReferringIPsStats `json:"stats"`
+}
+
+type ReferringIP struct {
+	IP               string `json:"ip"`
+	ReferringDomains int64  `json:"refdomains"`
+	Backlinks        int64  `json:"backlinks"`
+}
+
+type ReferringIPsStats struct {
+	IPs    int64 `json:"ips"`
+	ClassC int64 `json:"class_c"`
+}
+
+func (s *serviceImpl) ReferringIPs(ctx context.Context, opts ...Option) (*ReferringIPsResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("refips")
+	b.WithMode(ModeSubdomains)
+
+	payload := &ReferringIPsResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
+
+// Subnet aggregates the referring IPs that share a network.
+type Subnet struct {
+	// Network in CIDR notation, e.g. "203.0.113.0/24".
+	Network          string
+	IPs              []ReferringIP
+	ReferringDomains int64
+	Backlinks        int64
+}
+
+// GroupBySubnet groups IPv4 addresses by /24 (class C) network and IPv6
+// addresses by /64 network. Rows with an invalid IP are ignored. Subnets are
+// sorted by number of referring domains, largest first, so clusters of
+// domains hosted together come up at the top.
+func GroupBySubnet(ips []ReferringIP) []Subnet {
+	var subnets []Subnet
+	index := map[string]int{}
+
+	for _, row := range ips {
+		network, ok := subnetOf(row.IP)
+		if !ok {
+			continue
+		}
+		i, ok := index[network]
+		if !ok {
+			i = len(subnets)
+			index[network] = i
+			subnets = append(subnets, Subnet{Network: network})
+		}
+		subnets[i].IPs = append(subnets[i].IPs, row)
+		subnets[i].ReferringDomains += row.ReferringDomains
+		subnets[i].Backlinks += row.Backlinks
+	}
+
+	sort.SliceStable(subnets, func(i, j int) bool {
+		if subnets[i].ReferringDomains != subnets[j].ReferringDomains {
+			return subnets[i].ReferringDomains > subnets[j].ReferringDomains
+		}
+		return subnets[i].Network < subnets[j].Network
+	})
+
+	return subnets
+}
+
+func subnetOf(ip string) (string, bool) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return "", false
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		n := net.IPNet{IP: v4.Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}
+		return n.String(), true
+	}
+	n := net.IPNet{IP: parsed.Mask(net.CIDRMask(64, 128)), Mask: net.CIDRMask(64, 128)}
+	return n.String(), true
+}
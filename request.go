@@ -1,11 +1,15 @@
 package ahrefs
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/oporto723/ahrefs-go/expr"
 )
 
 type requestBuilder struct {
@@ -14,14 +18,42 @@ type requestBuilder struct {
 	columns string
 	from    string
 	target  string
-	mode    string
-	where   string
-	having  string
-	orderBy string
-	limit   string
+	// Target set by WithTarget, rendered according to the final mode.
+	parsedTarget *Target
+	mode         Mode
+	modeSet      bool
+	where        expr.Expr
+	having       string
+	orderBy      string
+	limit        string
+
+	// Method defaults for the where parameter. User filters are combined with
+	// them using and, unless noDefaults is set.
+	defaultWhere   expr.Expr
+	defaultCountry string
+	noDefaults     bool
+
+	// Country set by the user, replacing defaultCountry.
+	country string
+
+	// Link types the rows are restricted to, if any.
+	linkTypes []LinkType
+
+	// When set, columns are not checked against the table schema.
+	noValidation bool
+
+	// Date range of historical rows, open on the side of a zero time.
+	dateFrom time.Time
+	dateTo   time.Time
 
 	// User-provided options.
 	opts []Option
+
+	// First error reported by an option, returned when building the request.
+	err error
+
+	// When set, the request is recorded instead of being sent.
+	explain *Explanation
 }
 
 func (r *requestBuilder) WithColumns(columns string) *requestBuilder {
@@ -36,19 +68,43 @@ func (r *requestBuilder) WithFrom(from string) *requestBuilder {
 
 func (r *requestBuilder) WithTarget(target string) *requestBuilder {
 	r.target = target
+	r.parsedTarget = nil
 	return r
 }
 
-func (r *requestBuilder) WithMode(mode string) *requestBuilder {
+func (r *requestBuilder) WithMode(mode Mode) *requestBuilder {
 	r.mode = mode
 	return r
 }
 
 func (r *requestBuilder) WithWhere(where string) *requestBuilder {
+	r.where = nil
+	if where != "" {
+		r.where = expr.Raw(where)
+	}
+	return r
+}
+
+func (r *requestBuilder) WithWhereExpr(where expr.Expr) *requestBuilder {
 	r.where = where
 	return r
 }
 
+func (r *requestBuilder) WithDefaultWhere(where expr.Expr) *requestBuilder {
+	r.defaultWhere = where
+	return r
+}
+
+func (r *requestBuilder) WithDefaultCountry(country string) *requestBuilder {
+	r.defaultCountry = country
+	return r
+}
+
+func (r *requestBuilder) WithCountry(country string) *requestBuilder {
+	r.country = strings.ToLower(country)
+	return r
+}
+
 func (r *requestBuilder) WithHaving(having string) *requestBuilder {
 	r.having = having
 	return r
@@ -64,6 +120,12 @@ func (r *requestBuilder) WithLimit(limit string) *requestBuilder {
 	return r
 }
 
+func (r *requestBuilder) setErr(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
 func (r *requestBuilder) request() (*http.Request, error) {
 	if !strings.HasSuffix(r.client.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", r.client.BaseURL)
@@ -82,8 +144,23 @@ func (r *requestBuilder) request() (*http.Request, error) {
 	for _, fn := range r.opts {
 		fn(r)
 	}
+	if r.err != nil {
+		return nil, r.err
+	}
 
-	q.Add("output", "json")
+	whereExpr, err := r.whereExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.validate(whereExpr); err != nil {
+		return nil, err
+	}
+	where, err := renderWhere(whereExpr)
+	if err != nil {
+		return nil, err
+	}
+
+	q.Add("output", OutputJSON.String())
 	if r.client.token != "" {
 		q.Add("token", r.client.token)
 	}
@@ -94,14 +171,16 @@ func (r *requestBuilder) request() (*http.Request, error) {
 	if r.from != "" {
 		q.Add("from", r.from)
 	}
-	if r.target != "" {
+	if r.parsedTarget != nil {
+		q.Add("target", r.parsedTarget.forMode(r.mode))
+	} else if r.target != "" {
 		q.Add("target", r.target)
 	}
 	if r.mode != "" {
-		q.Add("mode", r.mode)
+		q.Add("mode", string(r.mode))
 	}
-	if r.where != "" {
-		q.Add("where", r.where)
+	if where != "" {
+		q.Add("where", where)
 	}
 	if r.having != "" {
 		q.Add("having", r.having)
@@ -127,12 +206,168 @@ func (r *requestBuilder) request() (*http.Request, error) {
 	return req, nil
 }
 
-// Option is a function that changes the request.
+// whereExpr combines the method defaults, the country, the date range and the
+// user filter into the where parameter.
+func (r *requestBuilder) whereExpr() (expr.Expr, error) {
+	var parts []expr.Expr
+	country := r.country
+	if !r.noDefaults {
+		if r.defaultWhere != nil {
+			parts = append(parts, r.defaultWhere)
+		}
+		if country == "" {
+			country = r.defaultCountry
+		}
+	}
+	if r.country != "" {
+		if table, ok := LookupTable(r.from); ok {
+			if _, ok := table.Column("country"); !ok {
+				return nil, fmt.Errorf("country: table %s has no country dimension", table.Name)
+			}
+		}
+	}
+	if country != "" {
+		parts = append(parts, expr.Eq(expr.Col("country"), expr.String(country)))
+	}
+	dates, err := r.dateExpr()
+	if err != nil {
+		return nil, err
+	}
+	if dates != nil {
+		parts = append(parts, dates)
+	}
+	if len(r.linkTypes) > 0 {
+		var types []expr.Expr
+		for _, t := range r.linkTypes {
+			types = append(types, expr.Eq(expr.Col("link_type"), expr.String(string(t))))
+		}
+		if len(types) == 1 {
+			parts = append(parts, types[0])
+		} else {
+			parts = append(parts, expr.Or(types...))
+		}
+	}
+	if r.where != nil {
+		parts = append(parts, r.where)
+	}
+
+	switch len(parts) {
+	case 0:
+		return nil, nil
+	case 1:
+		return parts[0], nil
+	default:
+		return expr.And(parts...), nil
+	}
+}
+
+func renderWhere(where expr.Expr) (string, error) {
+	if where == nil {
+		return "", nil
+	}
+	s, err := expr.Render(where)
+	if err != nil {
+		return "", fmt.Errorf("where: %w", err)
+	}
+	return s, nil
+}
+
+// Option is a function that changes the request. Options are applied after
+// the defaults of the Service method, so they take precedence over them.
 type Option func(*requestBuilder)
 
+// WithFrom sets the table to query.
+func WithFrom(table string) Option {
+	return func(rb *requestBuilder) {
+		rb.WithFrom(table)
+	}
+}
+
+// WithSelect sets the columns to return. Without columns, all the columns of
+// the table are returned.
+func WithSelect(columns ...string) Option {
+	return func(rb *requestBuilder) {
+		for _, column := range columns {
+			if column == "" {
+				rb.setErr(errors.New("select: empty column name"))
+				return
+			}
+		}
+		rb.WithColumns(strings.Join(columns, ","))
+	}
+}
+
+// WithMode sets how the target is matched.
+func WithMode(mode Mode) Option {
+	return func(rb *requestBuilder) {
+		if !mode.Valid() {
+			rb.setErr(fmt.Errorf("mode: invalid mode %q", string(mode)))
+			return
+		}
+		rb.WithMode(mode)
+		rb.modeSet = true
+	}
+}
+
+// Order sorts the results on a column.
+type Order struct {
+	Column string
+	Desc   bool
+}
+
+// Asc sorts column in ascending order.
+func Asc(column string) Order {
+	return Order{Column: column}
+}
+
+// Desc sorts column in descending order.
+func Desc(column string) Order {
+	return Order{Column: column, Desc: true}
+}
+
+func (o Order) String() string {
+	if o.Desc {
+		return o.Column + ":desc"
+	}
+	return o.Column + ":asc"
+}
+
+// WithOrderBy sorts the results. Later orders break ties of earlier ones.
+// Without orders, the default ordering of the method is cleared.
+func WithOrderBy(orders ...Order) Option {
+	return func(rb *requestBuilder) {
+		if len(orders) == 0 {
+			rb.WithOrderBy("")
+			return
+		}
+		parts := make([]string, 0, len(orders))
+		for _, o := range orders {
+			if o.Column == "" {
+				rb.setErr(errors.New("order by: empty column name"))
+				return
+			}
+			parts = append(parts, o.String())
+		}
+		rb.WithOrderBy(strings.Join(parts, ","))
+	}
+}
+
+// WithTarget sets the domain or URL to query. The target is normalized with
+// ParseTarget. Bare hosts keep the default mode of the method; for targets
+// with a path, the mode implied by the path is used instead, unless WithMode
+// is set.
 func WithTarget(target string) Option {
 	return func(rb *requestBuilder) {
-		rb.WithTarget(target)
+		t, err := ParseTarget(target)
+		if err != nil {
+			rb.setErr(err)
+			return
+		}
+		rb.WithTarget(t.String())
+		rb.parsedTarget = t
+		if t.Path != "" && !rb.modeSet {
+			rb.WithMode(t.Mode)
+		}
 	}
 }
 
@@ -148,8 +383,76 @@ func WithHaving(having string) Option {
 	}
 }
 
+// WithWhere filters the rows. The filter is combined with the default filters
+// of the method using and; use WithoutDefaultFilters to replace them instead.
 func WithWhere(where string) Option {
 	return func(rb *requestBuilder) {
 		rb.WithWhere(where)
 	}
 }
+
+// WithWhereExpr filters the rows using a typed expression, like WithWhere.
+// Invalid expressions are reported when the request is built.
+func WithWhereExpr(e expr.Expr) Option {
+	return func(rb *requestBuilder) {
+		rb.WithWhereExpr(e)
+	}
+}
+
+// WithHavingExpr sets the having parameter from a typed expression. Invalid
+// expressions are reported when the request is built.
+func WithHavingExpr(e expr.Expr) Option {
+	return func(rb *requestBuilder) {
+		having, err := expr.Render(e)
+		if err != nil {
+			rb.setErr(fmt.Errorf("having: %w", err))
+			return
+		}
+		rb.WithHaving(having)
+	}
+}
+
+// WithoutDefaultFilters drops the default filters of the method, including its
+// default country. Filters set with WithWhere and WithCountry still apply.
+func WithoutDefaultFilters() Option {
+	return func(rb *requestBuilder) {
+		rb.noDefaults = true
+	}
+}
+
+// WithoutValidation sends the request without checking its columns against
+// the table schema, e.g. to use columns the library does not know about yet.
+func WithoutValidation() Option {
+	return func(rb *requestBuilder) {
+		rb.noValidation = true
+	}
+}
+
+// WithCountry restricts the rows to a country, given as an ISO 3166-1 alpha-2
+// code such as "us" or "de". It replaces the default country of the method.
+func WithCountry(country string) Option {
+	return func(rb *requestBuilder) {
+		if !validCountry(country) {
+			rb.setErr(fmt.Errorf("country: invalid country code %q", country))
+			return
+		}
+		rb.WithCountry(country)
+	}
+}
+
+// WithLinkTypes restricts backlinks to the given link types.
+func WithLinkTypes(types ...LinkType) Option {
+	return func(rb *requestBuilder) {
+		if len(types) == 0 {
+			rb.setErr(errors.New("link types: no link type"))
+			return
+		}
+		for _, t := range types {
+			if !t.Valid() {
+				rb.setErr(fmt.Errorf("link types: invalid link type %q", string(t)))
+				return
+			}
+		}
+		rb.linkTypes = types
+	}
+}
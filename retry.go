@@ -0,0 +1,221 @@
+package ahrefs
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryPolicy defines when and how failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry. It doubles on every
+	// retry, up to MaxBackoff, and a random jitter of up to half of it is
+	// removed so that concurrent clients do not retry in lockstep. A
+	// Retry-After header longer than MaxBackoff stops the retries. A zero
+	// MaxBackoff stands for 30 seconds.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// RetryOn lists the kinds of ErrorResponse that are retried.
+	RetryOn []ErrorKind
+
+	// RetryTransportErrors retries failures to reach the API, such as
+	// timeouts and connection resets.
+	RetryTransportErrors bool
+
+	// OnAttempt, when set, is called after every attempt.
+	OnAttempt func(RetryAttempt)
+}
+
+// RetryAttempt describes an attempt of a request.
+type RetryAttempt struct {
+	// Attempt is the attempt number, starting at 1.
+	Attempt int
+	// Response is the HTTP response, nil when the API could not be reached.
+	Response *http.Response
+	// Err is the error of the attempt, nil on success.
+	Err error
+	// Delay is the wait before the next attempt, zero when the request is
+	// not retried.
+	Delay time.Duration
+}
+
+// WithRetryPolicy retries failed requests according to p. The policy is
+// copied, so later changes to p do not affect the client.
+func WithRetryPolicy(p *RetryPolicy) ClientOption {
+	return func(c *Client) {
+		if p == nil {
+			c.RetryPolicy = nil
+			return
+		}
+		policy := *p
+		policy.RetryOn = append([]ErrorKind(nil), p.RetryOn...)
+		c.RetryPolicy = &policy
+	}
+}
+
+// defaultMaxBackoff is the MaxBackoff used when the policy sets none.
+const defaultMaxBackoff = 30 * time.Second
+
+// DefaultRetryPolicy returns a policy retrying up to 3 times on rate limits,
+// server errors and transport errors, waiting from half a second up to 30
+// seconds between attempts.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:          4,
+		MinBackoff:           500 * time.Millisecond,
+		MaxBackoff:           defaultMaxBackoff,
+		RetryOn:              []ErrorKind{KindRateLimited, KindServer},
+		RetryTransportErrors: true,
+	}
+}
+
+func (p *RetryPolicy) retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		for _, kind := range p.RetryOn {
+			if errResp.Kind == kind {
+				return true
+			}
+		}
+		return false
+	}
+	var urlErr *url.Error
+	return p.RetryTransportErrors && errors.As(err, &urlErr)
+}
+
+var (
+	jitterMu sync.Mutex
+	jitter   = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// backoff returns the delay before the retry following attempt. A
+// Retry-After header takes precedence over the computed delay; it reports
+// false when the header asks to wait longer than MaxBackoff.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = defaultMaxBackoff
+	}
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return d, d <= max
+		}
+	}
+
+	// Doubling stops once max is reached, so d cannot overflow.
+	d := p.MinBackoff
+	for i := 1; i < attempt && d > 0 && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	if half := int64(d / 2); half > 0 {
+		jitterMu.Lock()
+		d -= time.Duration(jitter.Int63n(half))
+		jitterMu.Unlock()
+	}
+	return d, true
+}
+
+// retryAfter parses a Retry-After header, given either in seconds or as an
+// HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// send sends req, retrying according to the retry policy of the client. On
+// success the body of the response is left open.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	policy := c.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		resp, err := c.roundTrip(ctx, req)
+
+		retry := err != nil && policy != nil && attempt < policy.MaxAttempts && policy.retryable(ctx, err)
+		var delay time.Duration
+		if retry {
+			delay, retry = policy.backoff(attempt, resp)
+			if !retry {
+				delay = 0
+			}
+		}
+		if policy != nil && policy.OnAttempt != nil {
+			policy.OnAttempt(RetryAttempt{Attempt: attempt, Response: resp, Err: err, Delay: delay})
+		}
+		if !retry {
+			return resp, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// roundTrip makes a single attempt of req. API errors are returned as
+// *ErrorResponse, with the body of the response closed.
+func (c *Client) roundTrip(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.client.Do(req.Clone(ctx))
+	if err != nil {
+		// If we got an error, and the context has been canceled,
+		// the context's error is probably more useful.
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		// If the error type is *url.Error, sanitize its URL before returning.
+		if e, ok := err.(*url.Error); ok {
+			if url, err := url.Parse(e.URL); err == nil {
+				e.URL = sanitizeURL(url).String()
+				return nil, e
+			}
+		}
+
+		return nil, err
+	}
+
+	if code := resp.StatusCode; code < 200 || code >= 300 || resp.Header.Get("X-Status") == "error" {
+		defer resp.Body.Close()
+		return resp, newErrorResponse(resp)
+	}
+
+	return resp, nil
+}
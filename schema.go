@@ -0,0 +1,413 @@
+package ahrefs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/oporto723/ahrefs-go/expr"
+)
+
+// ColumnType is the type of the values of a column.
+type ColumnType int
+
+const (
+	TypeString ColumnType = iota
+	TypeInt
+	TypeFloat
+	TypeBool
+	// TypeDate is a date without time, e.g. "2020-12-31".
+	TypeDate
+	// TypeTime is a date and time, e.g. "2020-12-31T23:59:59Z".
+	TypeTime
+)
+
+func (t ColumnType) String() string {
+	switch t {
+	case TypeString:
+		return "string"
+	case TypeInt:
+		return "int"
+	case TypeFloat:
+		return "float"
+	case TypeBool:
+		return "bool"
+	case TypeDate:
+		return "date"
+	case TypeTime:
+		return "time"
+	}
+	return fmt.Sprintf("ColumnType(%d)", int(t))
+}
+
+// ColumnSchema describes a column of a table.
+type ColumnSchema struct {
+	Name       string
+	Type       ColumnType
+	Filterable bool
+	Sortable   bool
+}
+
+// TableSchema describes the columns of a table.
+type TableSchema struct {
+	Name string
+	// Rows is the key of the response holding the rows of the table.
+	Rows string
+	// Date is the column holding the date of historical rows, filtered by
+	// WithDate and WithDateRange. It is empty for tables without history.
+	Date    string
+	Columns []ColumnSchema
+}
+
+// Column returns the schema of the named column.
+func (t *TableSchema) Column(name string) (ColumnSchema, bool) {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return ColumnSchema{}, false
+}
+
+// LookupTable returns the schema of the named table. Requests to tables
+// without a schema are sent without validation.
+func LookupTable(name string) (*TableSchema, bool) {
+	t, ok := schemas[name]
+	return t, ok
+}
+
+// InvalidColumn is a column rejected by the schema of a table.
+type InvalidColumn struct {
+	// Parameter is the query parameter referencing the column: select,
+	// where, having or orderBy.
+	Parameter string
+	Column    string
+	// Reason is "unknown", "not filterable" or "not sortable".
+	Reason string
+}
+
+// SchemaError is returned when a request references columns that are not
+// valid for the queried table.
+type SchemaError struct {
+	Table   string
+	Columns []InvalidColumn
+}
+
+func (e *SchemaError) Error() string {
+	parts := make([]string, 0, len(e.Columns))
+	for _, c := range e.Columns {
+		parts = append(parts, fmt.Sprintf("%s: column '%s' %s", c.Parameter, c.Column, c.Reason))
+	}
+	return fmt.Sprintf("table %s: %s", e.Table, strings.Join(parts, ", "))
+}
+
+// validate checks the columns of the request against the schema of its
+// table.
+func (r *requestBuilder) validate(where expr.Expr) error {
+	table, ok := LookupTable(r.from)
+	if !ok || r.noValidation {
+		return nil
+	}
+
+	var invalid []InvalidColumn
+	check := func(param, name string, need func(ColumnSchema) (bool, string)) {
+		c, ok := table.Column(name)
+		if !ok {
+			invalid = append(invalid, InvalidColumn{param, name, "unknown"})
+			return
+		}
+		if ok, reason := need(c); !ok {
+			invalid = append(invalid, InvalidColumn{param, name, reason})
+		}
+	}
+	exists := func(ColumnSchema) (bool, string) { return true, "" }
+	filterable := func(c ColumnSchema) (bool, string) { return c.Filterable, "not filterable" }
+	sortable := func(c ColumnSchema) (bool, string) { return c.Sortable, "not sortable" }
+
+	if r.columns != "" {
+		for _, name := range strings.Split(r.columns, ",") {
+			check("select", name, exists)
+		}
+	}
+	if where != nil {
+		for _, name := range expr.Columns(where) {
+			check("where", name, filterable)
+		}
+	}
+	if r.having != "" {
+		for _, name := range expr.Columns(expr.Raw(r.having)) {
+			check("having", name, filterable)
+		}
+	}
+	if r.orderBy != "" {
+		for _, order := range strings.Split(r.orderBy, ",") {
+			check("orderBy", strings.SplitN(order, ":", 2)[0], sortable)
+		}
+	}
+
+	if len(invalid) > 0 {
+		return &SchemaError{Table: table.Name, Columns: invalid}
+	}
+	return nil
+}
+
+// column returns a filterable and sortable column.
+func column(name string, typ ColumnType) ColumnSchema {
+	return ColumnSchema{Name: name, Type: typ, Filterable: true, Sortable: true}
+}
+
+// textColumn returns a filterable column that cannot be sorted on.
+func textColumn(name string) ColumnSchema {
+	return ColumnSchema{Name: name, Type: TypeString, Filterable: true}
+}
+
+func columns(groups ...[]ColumnSchema) []ColumnSchema {
+	var all []ColumnSchema
+	for _, g := range groups {
+		all = append(all, g...)
+	}
+	return all
+}
+
+var refpageColumns = []ColumnSchema{
+	column("url_from", TypeString),
+	column("ahrefs_rank", TypeInt),
+	column("domain_rating", TypeInt),
+	column("ahrefs_top", TypeInt),
+	column("ip_from", TypeString),
+	column("links_internal", TypeInt),
+	column("links_external", TypeInt),
+	column("page_size", TypeInt),
+	column("encoding", TypeString),
+	textColumn("title"),
+	column("language", TypeString),
+	column("url_to", TypeString),
+	column("first_seen", TypeTime),
+	column("last_visited", TypeTime),
+	column("prev_visited", TypeTime),
+	column("original", TypeBool),
+	column("redirect", TypeInt),
+	textColumn("alt"),
+	textColumn("anchor"),
+	textColumn("text_pre"),
+	textColumn("text_post"),
+	column("http_code", TypeInt),
+	column("url_from_first_seen", TypeTime),
+	column("url_to_first_seen", TypeTime),
+	column("first_origin", TypeString),
+	column("last_origin", TypeString),
+	column("link_type", TypeString),
+	column("nofollow", TypeBool),
+	column("ugc", TypeBool),
+	column("sponsored", TypeBool),
+}
+
+var pageColumns = []ColumnSchema{
+	column("url", TypeString),
+	column("ahrefs_rank", TypeInt),
+	column("first_seen", TypeTime),
+	column("last_visited", TypeTime),
+	column("http_code", TypeInt),
+	column("size", TypeInt),
+	column("links_internal", TypeInt),
+	column("links_external", TypeInt),
+	column("encoding", TypeString),
+	textColumn("title"),
+	column("redirect_url", TypeString),
+	column("content_encoding", TypeString),
+}
+
+// linkTypeColumns are the boolean link attributes the *_by_type tables can be
+// filtered on.
+var linkTypeColumns = []ColumnSchema{
+	column("text", TypeBool),
+	column("image", TypeBool),
+	column("nofollow", TypeBool),
+	column("ugc", TypeBool),
+	column("sponsored", TypeBool),
+	column("dofollow", TypeBool),
+	column("redirect", TypeBool),
+	column("canonical", TypeBool),
+	column("gov", TypeBool),
+	column("edu", TypeBool),
+	column("rss", TypeBool),
+	column("alternate", TypeBool),
+}
+
+var newLostColumns = []ColumnSchema{
+	column("type", TypeString),
+	column("date", TypeDate),
+}
+
+var anchorColumns = []ColumnSchema{
+	textColumn("anchor"),
+	column("backlinks", TypeInt),
+	column("refpages", TypeInt),
+	column("refdomains", TypeInt),
+	column("first_seen", TypeTime),
+	column("last_visited", TypeTime),
+}
+
+var linkedDomainColumns = []ColumnSchema{
+	column("domain_from", TypeString),
+	column("domain_to", TypeString),
+	column("domain_to_rating", TypeInt),
+	column("links", TypeInt),
+	column("unique_pages", TypeInt),
+	column("first_seen", TypeTime),
+	column("last_visited", TypeTime),
+}
+
+var metricsColumns = []ColumnSchema{
+	column("backlinks", TypeInt),
+	column("refpages", TypeInt),
+	column("pages", TypeInt),
+	column("valid_pages", TypeInt),
+	column("text", TypeInt),
+	column("image", TypeInt),
+	column("nofollow", TypeInt),
+	column("dofollow", TypeInt),
+	column("redirect", TypeInt),
+	column("canonical", TypeInt),
+	column("gov", TypeInt),
+	column("edu", TypeInt),
+	column("rss", TypeInt),
+	column("alternate", TypeInt),
+}
+
+var schemas = map[string]*TableSchema{}
+
+func init() {
+	for _, t := range []*TableSchema{
+		{Name: "ahrefs_rank", Rows: "pages", Columns: []ColumnSchema{
+			column("url", TypeString),
+			column("ahrefs_rank", TypeInt),
+		}},
+		{Name: "anchors", Rows: "anchors", Columns: anchorColumns},
+		{Name: "anchors_refdomains", Rows: "refdomains", Columns: []ColumnSchema{
+			textColumn("anchor"),
+			column("refdomain", TypeString),
+			column("domain_rating", TypeInt),
+			column("backlinks", TypeInt),
+			column("first_seen", TypeTime),
+			column("last_visited", TypeTime),
+		}},
+		{Name: "backlinks", Rows: "refpages", Columns: refpageColumns},
+		{Name: "backlinks_new_lost", Rows: "refpages", Date: "date", Columns: columns(newLostColumns, refpageColumns)},
+		{Name: "backlinks_new_lost_counters", Rows: "counts", Date: "date", Columns: []ColumnSchema{
+			column("date", TypeDate),
+			column("new", TypeInt),
+			column("lost", TypeInt),
+			column("new_total", TypeInt),
+			column("lost_total", TypeInt),
+		}},
+		{Name: "backlinks_one_per_domain", Rows: "refpages", Columns: columns(refpageColumns, []ColumnSchema{
+			column("total_backlinks", TypeInt),
+		})},
+		{Name: "backlinks_pages", Rows: "pages", Columns: columns(pageColumns, []ColumnSchema{
+			column("backlinks", TypeInt),
+			column("refpages", TypeInt),
+			column("refdomains", TypeInt),
+		})},
+		{Name: "broken_backlinks", Rows: "refpages", Columns: refpageColumns},
+		{Name: "broken_links", Rows: "links", Columns: []ColumnSchema{
+			column("url_from", TypeString),
+			column("url_to", TypeString),
+			column("ahrefs_rank", TypeInt),
+			column("domain_to_rating", TypeInt),
+			column("http_code", TypeInt),
+			textColumn("anchor"),
+			column("link_type", TypeString),
+			column("nofollow", TypeBool),
+			column("first_seen", TypeTime),
+			column("last_visited", TypeTime),
+			column("prev_visited", TypeTime),
+		}},
+		{Name: "domain_rating", Rows: "domain", Columns: []ColumnSchema{
+			column("domain_rating", TypeInt),
+			column("ahrefs_top", TypeInt),
+		}},
+		{Name: "linked_anchors", Rows: "anchors", Columns: anchorColumns},
+		{Name: "linked_domains", Rows: "domains", Columns: linkedDomainColumns},
+		{Name: "linked_domains_by_type", Rows: "domains", Columns: columns(linkedDomainColumns, []ColumnSchema{
+			column("links_dofollow", TypeInt),
+			column("traffic", TypeFloat),
+		}, linkTypeColumns)},
+		{Name: "metrics", Rows: "metrics", Columns: metricsColumns},
+		{Name: "metrics_extended", Rows: "metrics", Columns: columns(metricsColumns, []ColumnSchema{
+			column("html_pages", TypeInt),
+			column("links_internal", TypeInt),
+			column("links_external", TypeInt),
+			column("refdomains", TypeInt),
+			column("refclass_c", TypeInt),
+			column("refips", TypeInt),
+			column("linked_root_domains", TypeInt),
+		})},
+		{Name: "pages", Rows: "pages", Columns: pageColumns},
+		{Name: "pages_extended", Rows: "pages", Columns: columns(pageColumns, []ColumnSchema{
+			column("ip", TypeString),
+			column("language", TypeString),
+			column("prev_visited", TypeTime),
+			column("backlinks", TypeInt),
+			column("backlinks_dofollow", TypeInt),
+			column("refpages", TypeInt),
+			column("refdomains", TypeInt),
+		})},
+		{Name: "pages_info", Rows: "pages", Columns: columns(pageColumns, []ColumnSchema{
+			column("ip", TypeString),
+			column("language", TypeString),
+			column("prev_visited", TypeTime),
+			column("canonical", TypeString),
+		})},
+		{Name: "positions_metrics", Rows: "metrics", Columns: []ColumnSchema{
+			column("positions", TypeInt),
+			column("positions_top10", TypeInt),
+			column("positions_top3", TypeInt),
+			column("traffic", TypeFloat),
+			column("traffic_top10", TypeFloat),
+			column("traffic_top3", TypeFloat),
+			column("cost", TypeFloat),
+			column("cost_top10", TypeFloat),
+			column("cost_top3", TypeFloat),
+			column("country", TypeString),
+		}},
+		{Name: "refdomains", Rows: "refdomains", Columns: []ColumnSchema{
+			column("refdomain", TypeString),
+			column("domain_rating", TypeInt),
+			column("backlinks", TypeInt),
+			column("refpages", TypeInt),
+			column("first_seen", TypeTime),
+			column("last_visited", TypeTime),
+		}},
+		{Name: "refdomains_by_type", Rows: "refdomains", Columns: columns([]ColumnSchema{
+			column("refdomain", TypeString),
+			column("refdomain_top", TypeString),
+			column("backlinks", TypeInt),
+			column("backlinks_dofollow", TypeInt),
+			column("refpages", TypeInt),
+			column("first_seen", TypeTime),
+			column("last_visited", TypeTime),
+			column("domain_rating", TypeInt),
+			column("traffic", TypeFloat),
+			column("linked_domains", TypeInt),
+			column("refdomains", TypeInt),
+		}, linkTypeColumns)},
+		{Name: "refdomains_new_lost", Rows: "refdomains", Date: "date", Columns: columns(newLostColumns, []ColumnSchema{
+			column("refdomain", TypeString),
+			column("domain_rating", TypeInt),
+			column("backlinks", TypeInt),
+		})},
+		{Name: "refdomains_new_lost_counters", Rows: "counts", Date: "date", Columns: []ColumnSchema{
+			column("date", TypeDate),
+			column("new", TypeInt),
+			column("lost", TypeInt),
+		}},
+		{Name: "refips", Rows: "refips", Columns: []ColumnSchema{
+			column("ip", TypeString),
+			column("refdomains", TypeInt),
+			column("backlinks", TypeInt),
+		}},
+		{Name: "subscription_info", Rows: "info"},
+	} {
+		schemas[t.Name] = t
+	}
+}
@@ -0,0 +1,237 @@
+package ahrefs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Stream queries any table of the API, like Query, and calls fn for each row
+// as it is read from the response, so that large results are never held in
+// memory. Rows are the raw JSON objects of the table; decode them with
+// json.Unmarshal. Streaming stops at the first error returned by fn, which is
+// then returned by Stream.
+func (c *Client) Stream(ctx context.Context, table string, fn func(row json.RawMessage) error, opts ...Option) (*http.Response, error) {
+	b := c.requestBuilder(ctx, opts)
+	b.WithFrom(table)
+	b.WithMode(ModeSubdomains)
+	b.noValidation = true
+
+	rows, resp, err := c.openRows(ctx, b)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.close()
+
+	for {
+		row, err := rows.next()
+		if err == io.EOF {
+			return resp, nil
+		}
+		if err != nil {
+			return resp, err
+		}
+		if err := fn(row); err != nil {
+			return resp, err
+		}
+	}
+}
+
+// openRows sends the request of b and returns a reader over the rows of the
+// response. The reader must be closed.
+func (c *Client) openRows(ctx context.Context, b *requestBuilder) (*rowReader, *http.Response, error) {
+	resp, err := c.doRequest(ctx, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	r := &rowReader{
+		resp:  resp,
+		dec:   json.NewDecoder(resp.Body),
+		table: b.from,
+	}
+	if schema, ok := LookupTable(b.from); ok {
+		r.rows = schema.Rows
+	}
+	return r, resp, nil
+}
+
+const (
+	readerStart = iota
+	readerSections
+	readerArray
+	readerDone
+)
+
+// rowReader decodes the rows of a response one at a time. The rows are read
+// from the section named after the table schema or, for unknown tables, from
+// the first array of the payload, or else its only object section besides
+// stats. Other sections are skipped.
+type rowReader struct {
+	resp  *http.Response
+	dec   *json.Decoder
+	table string
+	rows  string
+
+	state int
+	found bool
+	err   error
+
+	// Object sections of unknown tables, one of which may hold the rows.
+	candidates []json.RawMessage
+}
+
+// next returns the next row, or io.EOF when there are no more rows.
+func (r *rowReader) next() (json.RawMessage, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	row, err := r.advance()
+	if err != nil {
+		r.err = err
+	}
+	return row, err
+}
+
+func (r *rowReader) close() error {
+	return r.resp.Body.Close()
+}
+
+func (r *rowReader) advance() (json.RawMessage, error) {
+	for {
+		switch r.state {
+		case readerStart:
+			if err := expectDelim(r.dec, '{'); err != nil {
+				return nil, err
+			}
+			r.state = readerSections
+
+		case readerArray:
+			if r.dec.More() {
+				var row json.RawMessage
+				if err := r.dec.Decode(&row); err != nil {
+					return nil, err
+				}
+				return row, nil
+			}
+			if err := expectDelim(r.dec, ']'); err != nil {
+				return nil, err
+			}
+			r.state = readerSections
+
+		case readerSections:
+			if !r.dec.More() {
+				if err := expectDelim(r.dec, '}'); err != nil {
+					return nil, err
+				}
+				r.state = readerDone
+				continue
+			}
+			row, err := r.section()
+			if err != nil || row != nil {
+				return row, err
+			}
+
+		case readerDone:
+			if r.found || r.rows != "" {
+				return nil, io.EOF
+			}
+			r.found = true
+			if len(r.candidates) == 1 {
+				return r.candidates[0], nil
+			}
+			return nil, fmt.Errorf("cannot find the rows of table %q", r.table)
+		}
+	}
+}
+
+// section reads the next section of the payload. It returns the row of an
+// object section holding the rows, and starts reading array rows.
+func (r *rowReader) section() (json.RawMessage, error) {
+	tok, err := r.dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	key, _ := tok.(string)
+
+	switch {
+	case key == "error":
+		var message string
+		if err := r.dec.Decode(&message); err != nil || message == "" {
+			return nil, err
+		}
+		return nil, errorResponse(r.resp, message)
+	case r.found, key == "stats" && r.rows == "", r.rows != "" && key != r.rows:
+		return nil, skipValue(r.dec)
+	}
+
+	tok, err = r.dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('['):
+		r.found = true
+		r.state = readerArray
+		return nil, nil
+	case json.Delim('{'):
+		row, err := decodeObject(r.dec)
+		if err != nil {
+			return nil, err
+		}
+		if r.rows == "" {
+			r.candidates = append(r.candidates, row)
+			return nil, nil
+		}
+		r.found = true
+		return row, nil
+	case nil:
+		r.found = r.rows != ""
+		return nil, nil
+	default:
+		if r.rows != "" {
+			return nil, fmt.Errorf("rows of table %q: unexpected value %v", r.table, tok)
+		}
+		return nil, nil
+	}
+}
+
+// decodeObject decodes the rest of an object whose opening brace has been
+// read.
+func decodeObject(dec *json.Decoder) (json.RawMessage, error) {
+	obj := map[string]json.RawMessage{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := tok.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		obj[key] = value
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+func skipValue(dec *json.Decoder) error {
+	var value json.RawMessage
+	return dec.Decode(&value)
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("unexpected token %v, expected %v", tok, delim)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package ahrefs
+
+import (
+	"context"
+	"net/http"
+)
+
+type SubscriptionInfoResponse struct {
+	Info SubscriptionInfo `json:"info"`
+}
+
+// SubscriptionInfo describes the API quota of the account owning the token.
+// Querying it does not consume any rows.
+type SubscriptionInfo struct {
+	RowsLeft     int64  `json:"rows_left"`
+	RowsLimit    int64  `json:"rows_limit"`
+	Subscription string `json:"subscription"`
+}
+
+func (s *serviceImpl) SubscriptionInfo(ctx context.Context, opts ...Option) (*SubscriptionInfoResponse, *http.Response, error) {
+	b := s.client.requestBuilder(ctx, opts)
+	b.WithFrom("subscription_info")
+
+	payload := &SubscriptionInfoResponse{}
+	resp, err := s.client.Do(ctx, b, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return payload, resp, err
+}
@@ -0,0 +1,144 @@
+package ahrefs
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// Target is a normalized target of a request.
+type Target struct {
+	// Host in lower case and ASCII form. IPv6 addresses are bracketed.
+	Host string
+	// Path, including the query string if any. It is empty for a whole
+	// domain.
+	Path string
+	// Mode is the default mode for the target: ModeDomain for a bare host,
+	// ModePrefix for a path ending with a slash or "*", ModeExact otherwise.
+	Mode Mode
+}
+
+// String returns the target as sent to the API.
+func (t *Target) String() string {
+	return t.Host + t.Path
+}
+
+// forMode returns the target as sent to the API in mode. In subdomains mode
+// the "www." prefix is dropped, as it matches the same rows.
+func (t *Target) forMode(mode Mode) string {
+	host := t.Host
+	if mode == ModeSubdomains && strings.HasPrefix(host, "www.") && strings.Count(host, ".") >= 2 {
+		host = strings.TrimPrefix(host, "www.")
+	}
+	return host + t.Path
+}
+
+// ParseTarget normalizes a domain or URL so that equivalent spellings, such
+// as "https://Example.com/" and "example.com", give the same target. The
+// scheme, default ports and fragment are dropped, the host is lower-cased and
+// converted to its IDNA ASCII form, and repeated trailing slashes are
+// collapsed. A "www." prefix is kept: it only makes no difference in
+// subdomains mode, where requests drop it.
+func ParseTarget(raw string) (*Target, error) {
+	s := strings.TrimSpace(raw)
+	if s == "" {
+		return nil, fmt.Errorf("target: empty target")
+	}
+	if !strings.Contains(s, "://") {
+		s = "http://" + s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("target: unsupported scheme %q", u.Scheme)
+	}
+	if u.User != nil {
+		return nil, fmt.Errorf("target: unexpected user info in %q", raw)
+	}
+
+	host, err := normalizeHost(u.Hostname())
+	if err != nil {
+		return nil, err
+	}
+	switch port := u.Port(); {
+	case port != "" && port != "80" && port != "443":
+		host = net.JoinHostPort(host, port)
+	case strings.Contains(host, ":"):
+		// Keep IPv6 addresses bracketed, so that the path and port are
+		// not mistaken for part of the address.
+		host = "[" + host + "]"
+	}
+
+	path := u.EscapedPath()
+	prefix := strings.HasSuffix(path, "*")
+	path = strings.TrimSuffix(path, "*")
+	if strings.HasSuffix(path, "/") {
+		path = strings.TrimRight(path, "/") + "/"
+		prefix = true
+	}
+	if path == "/" {
+		path = ""
+	}
+	if u.RawQuery != "" {
+		if path == "" {
+			path = "/"
+		}
+		path += "?" + u.RawQuery
+		prefix = false
+	}
+
+	t := &Target{Host: host, Path: path, Mode: ModeExact}
+	switch {
+	case path == "" && u.RawQuery == "":
+		t.Mode = ModeDomain
+	case prefix:
+		t.Mode = ModePrefix
+	}
+	return t, nil
+}
+
+func normalizeHost(host string) (string, error) {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "" {
+		return "", fmt.Errorf("target: missing host")
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return host, nil
+	}
+
+	ascii, err := idna.Lookup.ToASCII(host)
+	if err != nil {
+		return "", fmt.Errorf("target: invalid host %q: %w", host, err)
+	}
+	host = ascii
+
+	labels := strings.Split(host, ".")
+	if len(labels) < 2 {
+		return "", fmt.Errorf("target: invalid host %q", host)
+	}
+	for _, label := range labels {
+		if !validLabel(label) {
+			return "", fmt.Errorf("target: invalid host %q", host)
+		}
+	}
+	return host, nil
+}
+
+func validLabel(label string) bool {
+	if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
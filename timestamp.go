@@ -0,0 +1,66 @@
+package ahrefs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// timestampLayouts are the formats used by the API for dates and times.
+var timestampLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Timestamp is a date or a date and time returned by the API. Missing dates
+// are represented by the zero time.
+type Timestamp struct {
+	time.Time
+}
+
+// ParseTimestamp parses a date in any of the formats used by the API. Empty
+// strings and "0000-00-00" dates give the zero Timestamp.
+func ParseTimestamp(s string) (Timestamp, error) {
+	switch s {
+	case "", "0000-00-00", "0000-00-00 00:00:00", "0000-00-00T00:00:00Z":
+		return Timestamp{}, nil
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Timestamp{t}, nil
+		}
+	}
+	return Timestamp{}, fmt.Errorf("invalid timestamp %q", s)
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = Timestamp{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseTimestamp(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// String formats t as RFC 3339, or returns an empty string for the zero
+// Timestamp.
+func (t Timestamp) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}